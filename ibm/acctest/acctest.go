@@ -83,6 +83,7 @@ var WorkspaceID string
 var TemplateID string
 var ActionID string
 var JobID string
+var SchematicsAgentID string
 var RepoURL string
 var RepoBranch string
 var imageName string
@@ -91,6 +92,7 @@ var HpcsInstanceID string
 var SecretsManagerInstanceID string
 var SecretsManagerInstanceRegion string
 var SecretsManagerENInstanceCrn string
+var SecretsManagerCertificateManagerInstanceCrn string
 var SecretsManagerIamCredentialsConfigurationApiKey string
 var SecretsManagerIamCredentialsSecretServiceId string
 var SecretsManagerIamCredentialsSecretServiceAccessGroup string
@@ -137,6 +139,7 @@ var Pi_volume_group_id string
 var Pi_volume_onboarding_id string
 var Pi_network_name string
 var Pi_cloud_instance_id string
+var Pi_datacenter string
 var Pi_instance_name string
 var Pi_dhcp_id string
 var PiCloudConnectionName string
@@ -198,6 +201,10 @@ var ClusterName string
 // Satellite instance
 var Satellite_location_id string
 var Satellite_Resource_instance_id string
+var Satellite_cluster_id string
+
+// Container Registry
+var CrImage string
 
 // Dedicated host
 var HostPoolID string
@@ -758,6 +765,12 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_CLOUDINSTANCE_ID for testing ibm_pi_image resource else it is set to default value 'd16705bd-7f1a-48c9-9e0e-1c17b71e7331'")
 	}
 
+	Pi_datacenter = os.Getenv("PI_DATACENTER")
+	if Pi_datacenter == "" {
+		Pi_datacenter = "dal12"
+		fmt.Println("[INFO] Set the environment variable PI_DATACENTER for testing ibm_pi_workspace resource else it is set to default value 'dal12'")
+	}
+
 	Pi_instance_name = os.Getenv("PI_PVM_INSTANCE_NAME")
 	if Pi_instance_name == "" {
 		Pi_instance_name = "terraform-test-power"
@@ -847,6 +860,11 @@ func init() {
 		JobID = "us-east.ACTION.action_pm.a4ffeec3"
 		fmt.Println("[INFO] Set the environment variable SCHEMATICS_JOB_ID for testing schematics resources else it is set to default value")
 	}
+	SchematicsAgentID = os.Getenv("SCHEMATICS_AGENT_ID")
+	if SchematicsAgentID == "" {
+		SchematicsAgentID = "agent-tf-acc-test.soA.b8c3"
+		fmt.Println("[INFO] Set the environment variable SCHEMATICS_AGENT_ID for testing schematics agent resources else it is set to default value")
+	}
 	RepoURL = os.Getenv("SCHEMATICS_REPO_URL")
 	if RepoURL == "" {
 		fmt.Println("[INFO] Set the environment variable SCHEMATICS_REPO_URL for testing schematics resources else tests will fail if this is not set correctly")
@@ -918,6 +936,11 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_EN_INSTANCE_CRN for testing Event Notifications for Secrets Manager tests else tests will fail if this is not set correctly")
 	}
 
+	SecretsManagerCertificateManagerInstanceCrn = os.Getenv("SECRETS_MANAGER_CERTIFICATE_MANAGER_INSTANCE_CRN")
+	if SecretsManagerCertificateManagerInstanceCrn == "" {
+		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_CERTIFICATE_MANAGER_INSTANCE_CRN for testing the Certificate Manager migration tests else tests will fail if this is not set correctly")
+	}
+
 	SecretsManagerIamCredentialsConfigurationApiKey = os.Getenv("SECRETS_MANAGER_IAM_CREDENTIALS_CONFIGURATION_API_KEY")
 	if SecretsManagerENInstanceCrn == "" {
 		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_EN_INSTANCE_CRN for testing IAM Credentials secret's tests else tests will assume that IAM Credentials engine is already configured and fail if not set correctly")
@@ -1114,6 +1137,16 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable SATELLITE_RESOURCE_INSTANCE_ID for ibm_cos_bucket satellite location resource or datasource else tests will fail if this is not set correctly")
 	}
 
+	Satellite_cluster_id = os.Getenv("SATELLITE_CLUSTER_ID")
+	if Satellite_cluster_id == "" {
+		fmt.Println("[INFO] Set the environment variable SATELLITE_CLUSTER_ID for ibm_satellite_storage_assignment resource or datasource else tests will fail if this is not set correctly")
+	}
+
+	CrImage = os.Getenv("IBM_CR_IMAGE")
+	if CrImage == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CR_IMAGE with an image such as us.icr.io/namespace/repository:tag for ibm_cr_image_tag resource or ibm_cr_images and ibm_cr_va_report datasources else tests will fail if this is not set correctly")
+	}
+
 	HostPoolID = os.Getenv("IBM_CONTAINER_DEDICATEDHOST_POOL_ID")
 	if HostPoolID == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_CONTAINER_DEDICATEDHOST_POOL_ID for ibm_container_vpc_cluster resource to test dedicated host functionality")
@@ -25,6 +25,7 @@ import (
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv3"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cosconfig "github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	kp "github.com/IBM/keyprotect-go-client"
@@ -113,6 +114,7 @@ import (
 	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
 	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
 	"github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 	"github.com/IBM/ibm-hpcs-uko-sdk/ukov4"
 	"github.com/IBM/scc-go-sdk/v4/posturemanagementv1"
@@ -214,6 +216,7 @@ type ClientSession interface {
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
+	VulnerabilityAdvisorV3() (*vulnerabilityadvisorv3.VulnerabilityAdvisorV3, error)
 	FunctionClient() (*whisk.Client, error)
 	GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error)
 	GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error)
@@ -290,6 +293,7 @@ type ClientSession interface {
 	AtrackerV1() (*atrackerv1.AtrackerV1, error)
 	AtrackerV2() (*atrackerv2.AtrackerV2, error)
 	ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error)
+	ESadminRestSession() (*adminrestv1.AdminrestV1, error)
 	AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error)
 	ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error)
 	PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error)
@@ -326,6 +330,9 @@ type clientSession struct {
 	containerRegistryClientErr error
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
 
+	vulnerabilityAdvisorClientErr error
+	vulnerabilityAdvisorClient    *vulnerabilityadvisorv3.VulnerabilityAdvisorV3
+
 	certManagementErr error
 	certManagementAPI certificatemanager.CertificateManagerServiceAPI
 
@@ -587,6 +594,9 @@ type clientSession struct {
 	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
 	esSchemaRegistryErr    error
 
+	esAdminRestClient *adminrestv1.AdminrestV1
+	esAdminRestErr    error
+
 	// Security and Compliance Center (SCC) Admin
 	adminServiceApiClient    *adminserviceapiv1.AdminServiceApiV1
 	adminServiceApiClientErr error
@@ -660,6 +670,11 @@ func (session clientSession) ContainerRegistryV1() (*containerregistryv1.Contain
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
+// VulnerabilityAdvisorV3 provides Vulnerability Advisor Service APIs ...
+func (session clientSession) VulnerabilityAdvisorV3() (*vulnerabilityadvisorv3.VulnerabilityAdvisorV3, error) {
+	return session.vulnerabilityAdvisorClient, session.vulnerabilityAdvisorClientErr
+}
+
 // SchematicsAPI provides schematics Service APIs ...
 func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 	if sess.schematicsClientErr != nil {
@@ -1140,6 +1155,11 @@ func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.Schema
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
+// Event Streams Admin Rest
+func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	return session.esAdminRestClient, session.esAdminRestErr
+}
+
 // Security and Compliance center Admin API
 func (session clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
 	return session.adminServiceApiClient, session.adminServiceApiClientErr
@@ -1200,6 +1220,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.csConfigErr = errEmptyBluemixCredentials
 		session.csv2ConfigErr = errEmptyBluemixCredentials
 		session.containerRegistryClientErr = errEmptyBluemixCredentials
+		session.vulnerabilityAdvisorClientErr = errEmptyBluemixCredentials
 		session.kpErr = errEmptyBluemixCredentials
 		session.pushServiceClientErr = errEmptyBluemixCredentials
 		session.appConfigurationClientErr = errEmptyBluemixCredentials
@@ -1267,6 +1288,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = errEmptyBluemixCredentials
 		session.satelliteLinkClientErr = errEmptyBluemixCredentials
 		session.esSchemaRegistryErr = errEmptyBluemixCredentials
+		session.esAdminRestErr = errEmptyBluemixCredentials
 		session.contextBasedRestrictionsClientErr = errEmptyBluemixCredentials
 		session.postureManagementClientErr = errEmptyBluemixCredentials
 		session.postureManagementClientErrv2 = errEmptyBluemixCredentials
@@ -1851,6 +1873,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		})
 	}
 
+	// VULNERABILITY ADVISOR Service
+	// Vulnerability Advisor is served from the Container Registry endpoints.
+	vulnerabilityAdvisorClientOptions := &vulnerabilityadvisorv3.VulnerabilityAdvisorV3Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+		Account:       core.StringPtr(userConfig.UserAccount),
+	}
+	session.vulnerabilityAdvisorClient, err = vulnerabilityadvisorv3.NewVulnerabilityAdvisorV3(vulnerabilityAdvisorClientOptions)
+	if err != nil {
+		session.vulnerabilityAdvisorClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Vulnerability Advisor API service: %q", err)
+	}
+	if session.vulnerabilityAdvisorClient != nil && session.vulnerabilityAdvisorClient.Service != nil {
+		session.vulnerabilityAdvisorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.vulnerabilityAdvisorClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
@@ -3077,6 +3117,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		})
 	}
 
+	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
+		Authenticator: authenticator,
+	}
+	session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
+	if err != nil {
+		session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
+	}
+	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
+		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// Governance Service
 	var configServiceApiClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
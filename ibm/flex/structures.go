@@ -36,6 +36,7 @@ import (
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/sl"
 
@@ -3270,6 +3271,382 @@ func SetTags(d *schema.ResourceData) []iampolicymanagementv1.ResourceTag {
 	return []iampolicymanagementv1.ResourceTag{}
 }
 
+// PolicyRuleConditionOperators are the operators of the rule conditions of an
+// access policy.
+var PolicyRuleConditionOperators = []string{
+	"stringEquals", "stringExists", "stringMatch", "stringEqualsAnyOf", "stringMatchAnyOf",
+	"ipEquals", "ipInRange",
+	"dateTimeLessThan", "dateTimeLessThanOrEquals", "dateTimeGreaterThan", "dateTimeGreaterThanOrEquals",
+	"dateLessThan", "dateLessThanOrEquals", "dateGreaterThan", "dateGreaterThanOrEquals",
+	"timeLessThan", "timeLessThanOrEquals", "timeGreaterThan", "timeGreaterThanOrEquals",
+	"dayOfWeekEquals", "dayOfWeekAnyOf",
+}
+
+// PolicyRuleConditionsSchema is the rule_conditions schema shared by the access
+// policy resources.
+func PolicyRuleConditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		Description:   "Rule conditions enforced by the policy",
+		ConflictsWith: []string{"resource_tags"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Key of the condition",
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(PolicyRuleConditionOperators, false),
+					Description:  "Operator of the condition",
+				},
+				"value": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Value of the condition",
+				},
+			},
+		},
+	}
+}
+
+// PolicyRuleOperatorSchema is the rule_operator schema shared by the access
+// policy resources.
+func PolicyRuleOperatorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "and",
+		ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+		Description:  "Operator that joins the rule conditions, either 'and' or 'or'",
+	}
+}
+
+// PolicyPatternSchema is the pattern schema shared by the access policy
+// resources.
+func PolicyPatternSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"resource_tags"},
+		Description:   "Pattern of the rule conditions, for example time-based-conditions:once",
+	}
+}
+
+// IsV2Policy reports whether the policy carries v2-only settings, rule conditions or a
+// pattern, and therefore has to be managed through the v2 policy API.
+func IsV2Policy(d *schema.ResourceData) bool {
+	if _, ok := d.GetOk("rule_conditions"); ok {
+		return true
+	}
+	if _, ok := d.GetOk("pattern"); ok {
+		return true
+	}
+	return false
+}
+
+// GenerateV2PolicyOptions converts the subject, roles and resource built for a v1 access
+// policy into v2 create options carrying the rule conditions and pattern of the resource.
+func GenerateV2PolicyOptions(d *schema.ResourceData, subject iampolicymanagementv1.PolicySubject, roles []iampolicymanagementv1.PolicyRole, resource iampolicymanagementv1.PolicyResource) *iampolicymanagementv1.V2CreatePolicyOptions {
+	subjectAttributes := []iampolicymanagementv1.V2PolicyAttribute{}
+	for _, a := range subject.Attributes {
+		subjectAttributes = append(subjectAttributes, iampolicymanagementv1.V2PolicyAttribute{
+			Key:      a.Name,
+			Operator: core.StringPtr("stringEquals"),
+			Value:    *a.Value,
+		})
+	}
+
+	resourceAttributes := []iampolicymanagementv1.V2PolicyAttribute{}
+	for _, a := range resource.Attributes {
+		operator := "stringEquals"
+		if a.Operator != nil && *a.Operator != "" {
+			operator = *a.Operator
+		}
+		var value interface{} = *a.Value
+		if operator == "stringExists" {
+			value = *a.Value == "true"
+		}
+		resourceAttributes = append(resourceAttributes, iampolicymanagementv1.V2PolicyAttribute{
+			Key:      a.Name,
+			Operator: core.StringPtr(operator),
+			Value:    value,
+		})
+	}
+
+	policyRoles := make([]iampolicymanagementv1.PolicyRole, len(roles))
+	for i, role := range roles {
+		policyRoles[i] = iampolicymanagementv1.PolicyRole{RoleID: role.RoleID}
+	}
+
+	options := &iampolicymanagementv1.V2CreatePolicyOptions{
+		Type: core.StringPtr("access"),
+		Control: &iampolicymanagementv1.V2PolicyBaseControl{
+			Grant: &iampolicymanagementv1.V2PolicyBaseControlGrant{
+				Roles: policyRoles,
+			},
+		},
+		Subject: &iampolicymanagementv1.V2PolicyBaseSubject{
+			Attributes: subjectAttributes,
+		},
+		Resource: &iampolicymanagementv1.V2PolicyBaseResource{
+			Attributes: resourceAttributes,
+		},
+	}
+
+	if rule := ExpandV2PolicyRule(d); rule != nil {
+		options.Rule = rule
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		options.Pattern = core.StringPtr(pattern.(string))
+	}
+	if desc, ok := d.GetOk("description"); ok {
+		options.Description = core.StringPtr(desc.(string))
+	}
+
+	return options
+}
+
+// ExpandV2PolicyRule builds the policy rule from rule_conditions and rule_operator. A
+// single condition is sent as is, several are combined with rule_operator.
+func ExpandV2PolicyRule(d *schema.ResourceData) iampolicymanagementv1.V2PolicyBaseRuleIntf {
+	r, ok := d.GetOk("rule_conditions")
+	if !ok {
+		return nil
+	}
+	conditions := []iampolicymanagementv1.V2PolicyAttribute{}
+	for _, c := range r.(*schema.Set).List() {
+		condition := c.(map[string]interface{})
+		conditions = append(conditions, iampolicymanagementv1.V2PolicyAttribute{
+			Key:      core.StringPtr(condition["key"].(string)),
+			Operator: core.StringPtr(condition["operator"].(string)),
+			Value:    expandV2PolicyConditionValue(condition["operator"].(string), condition["value"].([]interface{})),
+		})
+	}
+	if len(conditions) == 1 {
+		return &iampolicymanagementv1.V2PolicyBaseRuleV2PolicyAttribute{
+			Key:      conditions[0].Key,
+			Operator: conditions[0].Operator,
+			Value:    conditions[0].Value,
+		}
+	}
+	operator := "and"
+	if op, ok := d.GetOk("rule_operator"); ok {
+		operator = op.(string)
+	}
+	return &iampolicymanagementv1.V2PolicyBaseRuleV2RuleWithConditions{
+		Operator:   core.StringPtr(operator),
+		Conditions: conditions,
+	}
+}
+
+// expandV2PolicyConditionValue sends list operators such as dayOfWeekAnyOf as an array
+// and every other operator as a single value.
+func expandV2PolicyConditionValue(operator string, values []interface{}) interface{} {
+	if strings.HasSuffix(operator, "AnyOf") || len(values) != 1 {
+		return ExpandStringList(values)
+	}
+	return values[0].(string)
+}
+
+// FlattenV2PolicyRuleConditions returns the conditions of a policy rule in the
+// rule_conditions schema shape.
+func FlattenV2PolicyRuleConditions(rule iampolicymanagementv1.V2PolicyBaseRuleIntf) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	r, ok := rule.(*iampolicymanagementv1.V2PolicyBaseRule)
+	if !ok || r == nil {
+		return result
+	}
+	if len(r.Conditions) == 0 && r.Key != nil {
+		result = append(result, flattenV2PolicyCondition(r.Key, r.Operator, r.Value))
+		return result
+	}
+	for _, c := range r.Conditions {
+		result = append(result, flattenV2PolicyCondition(c.Key, c.Operator, c.Value))
+	}
+	return result
+}
+
+// FlattenV2PolicyRuleOperator returns the operator combining several rule conditions.
+func FlattenV2PolicyRuleOperator(rule iampolicymanagementv1.V2PolicyBaseRuleIntf) string {
+	r, ok := rule.(*iampolicymanagementv1.V2PolicyBaseRule)
+	if !ok || r == nil || len(r.Conditions) == 0 || r.Operator == nil {
+		return ""
+	}
+	return *r.Operator
+}
+
+func flattenV2PolicyCondition(key, operator *string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"key":      key,
+		"operator": operator,
+		"value":    flattenV2PolicyAttributeValues(value),
+	}
+}
+
+func flattenV2PolicyAttributeValues(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, fmt.Sprintf("%v", item))
+		}
+		return values
+	case nil:
+		return []string{}
+	default:
+		return []string{fmt.Sprintf("%v", v)}
+	}
+}
+
+// ConvertV2PolicyToPolicy maps a v2 policy onto the v1 shape so the existing flatten
+// helpers can be reused for roles, resources and subjects.
+func ConvertV2PolicyToPolicy(v2Policy *iampolicymanagementv1.V2Policy) *iampolicymanagementv1.Policy {
+	policy := &iampolicymanagementv1.Policy{
+		ID:          v2Policy.ID,
+		Type:        v2Policy.Type,
+		Description: v2Policy.Description,
+		State:       v2Policy.State,
+	}
+	if v2Policy.Subject != nil {
+		subject := iampolicymanagementv1.PolicySubject{}
+		for _, a := range v2Policy.Subject.Attributes {
+			subject.Attributes = append(subject.Attributes, iampolicymanagementv1.SubjectAttribute{
+				Name:  a.Key,
+				Value: core.StringPtr(fmt.Sprintf("%v", a.Value)),
+			})
+		}
+		policy.Subjects = []iampolicymanagementv1.PolicySubject{subject}
+	}
+	if v2Policy.Control != nil && v2Policy.Control.Grant != nil {
+		policy.Roles = v2Policy.Control.Grant.Roles
+	}
+	if v2Policy.Resource != nil {
+		resource := iampolicymanagementv1.PolicyResource{}
+		for _, a := range v2Policy.Resource.Attributes {
+			resource.Attributes = append(resource.Attributes, iampolicymanagementv1.ResourceAttribute{
+				Name:     a.Key,
+				Value:    core.StringPtr(fmt.Sprintf("%v", a.Value)),
+				Operator: a.Operator,
+			})
+		}
+		policy.Resources = []iampolicymanagementv1.PolicyResource{resource}
+	}
+	return policy
+}
+
+// GetV2Policy fetches a policy through the v2 API. V2GetPolicy of the SDK decodes the
+// response into the v1 model and drops the rule and pattern, so the request is issued here.
+func GetV2Policy(client *iampolicymanagementv1.IamPolicyManagementV1, policyID string, headers map[string]string) (*iampolicymanagementv1.V2Policy, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, `/v2/policies/{policy_id}`, map[string]string{"policy_id": policyID})
+	if err != nil {
+		return nil, nil, err
+	}
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err := client.Service.Request(request, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+	var result *iampolicymanagementv1.V2Policy
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, iampolicymanagementv1.UnmarshalV2Policy)
+		if err != nil {
+			return nil, response, err
+		}
+		response.Result = result
+	}
+	return result, response, nil
+}
+
+// GetPolicy fetches a policy through the v1 API, or through the v2 API mapped onto the v1
+// shape when the resource carries rule conditions or a pattern.
+func GetPolicy(client *iampolicymanagementv1.IamPolicyManagementV1, d *schema.ResourceData, options *iampolicymanagementv1.GetPolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	if !IsV2Policy(d) {
+		return client.GetPolicy(options)
+	}
+	v2Policy, res, err := GetV2Policy(client, *options.PolicyID, options.Headers)
+	if err != nil || v2Policy == nil {
+		return nil, res, err
+	}
+	return ConvertV2PolicyToPolicy(v2Policy), res, nil
+}
+
+// CreatePolicy creates an access policy through the v2 API when the resource carries rule
+// conditions or a pattern, and through the v1 API otherwise.
+func CreatePolicy(client *iampolicymanagementv1.IamPolicyManagementV1, d *schema.ResourceData, options *iampolicymanagementv1.CreatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	if !IsV2Policy(d) {
+		return client.CreatePolicy(options)
+	}
+	v2Options := GenerateV2PolicyOptions(d, options.Subjects[0], options.Roles, options.Resources[0])
+	v2Options.Headers = options.Headers
+	v2Policy, res, err := client.V2CreatePolicy(v2Options)
+	if err != nil || v2Policy == nil {
+		return nil, res, err
+	}
+	return ConvertV2PolicyToPolicy(v2Policy), res, nil
+}
+
+// UpdatePolicy updates an access policy through the v2 API when the resource carries, or
+// used to carry, rule conditions or a pattern, and through the v1 API otherwise.
+func UpdatePolicy(client *iampolicymanagementv1.IamPolicyManagementV1, d *schema.ResourceData, options *iampolicymanagementv1.UpdatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	if !IsV2Policy(d) && !d.HasChange("rule_conditions") && !d.HasChange("pattern") {
+		return client.UpdatePolicy(options)
+	}
+	v2Options := GenerateV2PolicyOptions(d, options.Subjects[0], options.Roles, options.Resources[0])
+	v2UpdateOptions := client.NewV2UpdatePolicyOptions(*options.PolicyID, *options.IfMatch, *v2Options.Type, v2Options.Control)
+	v2UpdateOptions.Subject = v2Options.Subject
+	v2UpdateOptions.Resource = v2Options.Resource
+	v2UpdateOptions.Rule = v2Options.Rule
+	v2UpdateOptions.Pattern = v2Options.Pattern
+	v2UpdateOptions.Description = options.Description
+	v2UpdateOptions.Headers = options.Headers
+	v2Policy, res, err := client.V2UpdatePolicy(v2UpdateOptions)
+	if err != nil || v2Policy == nil {
+		return nil, res, err
+	}
+	return ConvertV2PolicyToPolicy(v2Policy), res, nil
+}
+
+// SetV2PolicyRule reads back the rule conditions, rule operator and pattern of a policy
+// through the v2 API, so that conditions added or removed outside of Terraform show up as
+// drift.
+func SetV2PolicyRule(d *schema.ResourceData, client *iampolicymanagementv1.IamPolicyManagementV1, policyID string, headers map[string]string) error {
+	v2Policy, res, err := GetV2Policy(client, policyID, headers)
+	if err != nil || v2Policy == nil {
+		return fmt.Errorf("[ERROR] Error retrieving policy rule: %s\n%s", err, res)
+	}
+	if v2Policy.Rule != nil {
+		d.Set("rule_conditions", FlattenV2PolicyRuleConditions(v2Policy.Rule))
+		if operator := FlattenV2PolicyRuleOperator(v2Policy.Rule); operator != "" {
+			d.Set("rule_operator", operator)
+		}
+	} else {
+		d.Set("rule_conditions", []map[string]interface{}{})
+	}
+	if v2Policy.Pattern != nil {
+		d.Set("pattern", *v2Policy.Pattern)
+	} else {
+		d.Set("pattern", "")
+	}
+	return nil
+}
+
 func GetIBMUniqueId(accountID, userEmail string, meta interface{}) (string, error) {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
@@ -259,6 +259,7 @@ func Provider() *schema.Provider {
 			"ibm_certificate_manager_certificate":   certificatemanager.DataIBMCertificateManagerCertificate(),
 			"ibm_cis":                               cis.DataSourceIBMCISInstance(),
 			"ibm_cis_dns_records":                   cis.DataSourceIBMCISDNSRecords(),
+			"ibm_cis_dns_zone_file":                 cis.DataSourceIBMCISDNSZoneFile(),
 			"ibm_cis_certificates":                  cis.DataSourceIBMCISCertificates(),
 			"ibm_cis_global_load_balancers":         cis.DataSourceIBMCISGlbs(),
 			"ibm_cis_origin_pools":                  cis.DataSourceIBMCISOriginPools(),
@@ -285,6 +286,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_waf_rules":                     cis.DataSourceIBMCISWAFRules(),
 			"ibm_cis_filters":                       cis.DataSourceIBMCISFilters(),
 			"ibm_cis_firewall_rules":                cis.DataSourceIBMCISFirewallRules(),
+			"ibm_cis_dnssec":                        cis.DataSourceIBMCISDNSSEC(),
 			"ibm_cloudant":                          cloudant.DataSourceIBMCloudant(),
 			"ibm_cloudant_database":                 cloudant.DataSourceIBMCloudantDatabase(),
 			"ibm_database":                          database.DataSourceIBMDatabaseInstance(),
@@ -323,6 +325,8 @@ func Provider() *schema.Provider {
 			"ibm_container_dedicated_host_flavors":  kubernetes.DataSourceIBMContainerDedicatedHostFlavors(),
 			"ibm_container_dedicated_host":          kubernetes.DataSourceIBMContainerDedicatedHost(),
 			"ibm_cr_namespaces":                     registry.DataIBMContainerRegistryNamespaces(),
+			"ibm_cr_images":                         registry.DataIBMContainerRegistryImages(),
+			"ibm_cr_va_report":                      registry.DataIBMContainerRegistryVaReport(),
 			"ibm_cloud_shell_account_settings":      cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                        cos.DataSourceIBMCosBucket(),
 			"ibm_cos_bucket_object":                 cos.DataSourceIBMCosBucketObject(),
@@ -331,6 +335,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                     classicinfrastructure.DataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":               eventstreams.DataSourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":              eventstreams.DataSourceIBMEventStreamsSchema(),
+			"ibm_event_streams_consumer_groups":     eventstreams.DataSourceIBMEventStreamsConsumerGroups(),
 			"ibm_hpcs":                              hpcs.DataSourceIBMHPCS(),
 			"ibm_hpcs_managed_key":                  hpcs.DataSourceIbmManagedKey(),
 			"ibm_hpcs_key_template":                 hpcs.DataSourceIbmKeyTemplate(),
@@ -356,6 +361,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_links":         iamidentity.DataSourceIBMIamTrustedProfileLinks(),
 			"ibm_iam_trusted_profiles":              iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":        iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_policy_check":                  iampolicy.DataSourceIBMIAMPolicyCheck(),
 
 			//backup as Service
 			"ibm_is_backup_policy":       vpc.DataSourceIBMIsBackupPolicy(),
@@ -536,6 +542,9 @@ func Provider() *schema.Provider {
 			"ibm_schematics_job":            schematics.DataSourceIBMSchematicsJob(),
 			"ibm_schematics_inventory":      schematics.DataSourceIBMSchematicsInventory(),
 			"ibm_schematics_resource_query": schematics.DataSourceIBMSchematicsResourceQuery(),
+			"ibm_schematics_agent":          schematics.DataSourceIBMSchematicsAgent(),
+			"ibm_schematics_agent_prs":      schematics.DataSourceIBMSchematicsAgentPrs(),
+			"ibm_schematics_agent_deploy":   schematics.DataSourceIBMSchematicsAgentDeploy(),
 
 			// // Added for Power Resources
 
@@ -586,6 +595,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_volume_remote_copy_relationship":        power.DataSourceIBMPIVolumeRemoteCopyRelationship(),
 			"ibm_pi_volume_onboardings":                     power.DataSourceIBMPIVolumeOnboardings(),
 			"ibm_pi_volume_onboarding":                      power.DataSourceIBMPIVolumeOnboarding(),
+			"ibm_pi_workspace":                              power.DataSourceIBMPIWorkspace(),
 
 			// // Added for private dns zones
 
@@ -601,17 +611,19 @@ func Provider() *schema.Provider {
 
 			// // Added for Direct Link
 
-			"ibm_dl_gateways":          directlink.DataSourceIBMDLGateways(),
-			"ibm_dl_offering_speeds":   directlink.DataSourceIBMDLOfferingSpeeds(),
-			"ibm_dl_port":              directlink.DataSourceIBMDirectLinkPort(),
-			"ibm_dl_ports":             directlink.DataSourceIBMDirectLinkPorts(),
-			"ibm_dl_gateway":           directlink.DataSourceIBMDLGateway(),
-			"ibm_dl_locations":         directlink.DataSourceIBMDLLocations(),
-			"ibm_dl_routers":           directlink.DataSourceIBMDLRouters(),
-			"ibm_dl_provider_ports":    directlink.DataSourceIBMDirectLinkProviderPorts(),
-			"ibm_dl_provider_gateways": directlink.DataSourceIBMDirectLinkProviderGateways(),
-			"ibm_dl_route_reports":     directlink.DataSourceIBMDLRouteReports(),
-			"ibm_dl_route_report":      directlink.DataSourceIBMDLRouteReport(),
+			"ibm_dl_gateways":                     directlink.DataSourceIBMDLGateways(),
+			"ibm_dl_offering_speeds":              directlink.DataSourceIBMDLOfferingSpeeds(),
+			"ibm_dl_port":                         directlink.DataSourceIBMDirectLinkPort(),
+			"ibm_dl_ports":                        directlink.DataSourceIBMDirectLinkPorts(),
+			"ibm_dl_gateway":                      directlink.DataSourceIBMDLGateway(),
+			"ibm_dl_locations":                    directlink.DataSourceIBMDLLocations(),
+			"ibm_dl_routers":                      directlink.DataSourceIBMDLRouters(),
+			"ibm_dl_provider_ports":               directlink.DataSourceIBMDirectLinkProviderPorts(),
+			"ibm_dl_provider_gateways":            directlink.DataSourceIBMDirectLinkProviderGateways(),
+			"ibm_dl_route_reports":                directlink.DataSourceIBMDLRouteReports(),
+			"ibm_dl_route_report":                 directlink.DataSourceIBMDLRouteReport(),
+			"ibm_dl_gateway_import_route_filters": directlink.DataSourceIBMDLGatewayImportRouteFilters(),
+			"ibm_dl_gateway_export_route_filters": directlink.DataSourceIBMDLGatewayExportRouteFilters(),
 
 			// //Added for Transit Gateway
 			"ibm_tg_gateway":                   transitgateway.DataSourceIBMTransitGateway(),
@@ -621,6 +633,7 @@ func Provider() *schema.Provider {
 			"ibm_tg_locations":                 transitgateway.DataSourceIBMTransitGatewaysLocations(),
 			"ibm_tg_location":                  transitgateway.DataSourceIBMTransitGatewaysLocation(),
 			"ibm_tg_route_report":              transitgateway.DataSourceIBMTransitGatewayRouteReport(),
+			"ibm_tg_route_report_diff":         transitgateway.DataSourceIBMTransitGatewayRouteReportDiff(),
 			"ibm_tg_route_reports":             transitgateway.DataSourceIBMTransitGatewayRouteReports(),
 
 			// //Added for BSS Enterprise
@@ -669,6 +682,8 @@ func Provider() *schema.Provider {
 			"ibm_satellite_link":                                satellite.DataSourceIBMSatelliteLink(),
 			"ibm_satellite_endpoint":                            satellite.DataSourceIBMSatelliteEndpoint(),
 			"ibm_satellite_cluster_worker_pool_zone_attachment": satellite.DataSourceIBMSatelliteClusterWorkerPoolAttachment(),
+			"ibm_satellite_storage_configuration":               satellite.DataSourceIBMSatelliteStorageConfiguration(),
+			"ibm_satellite_storage_assignment":                  satellite.DataSourceIBMSatelliteStorageAssignment(),
 
 			// // Catalog related resources
 			"ibm_cm_catalog":           catalogmanagement.DataSourceIBMCmCatalog(),
@@ -680,11 +695,13 @@ func Provider() *schema.Provider {
 
 			// //Added for Resource Tag
 			"ibm_resource_tag": globaltagging.DataSourceIBMResourceTag(),
+			"ibm_tags":         globaltagging.DataSourceIBMTags(),
 
 			// // Atracker
-			"ibm_atracker_targets":   atracker.DataSourceIBMAtrackerTargets(),
-			"ibm_atracker_routes":    atracker.DataSourceIBMAtrackerRoutes(),
-			"ibm_atracker_endpoints": atracker.DataSourceIBMAtrackerEndpoints(),
+			"ibm_atracker_targets":       atracker.DataSourceIBMAtrackerTargets(),
+			"ibm_atracker_routes":        atracker.DataSourceIBMAtrackerRoutes(),
+			"ibm_atracker_endpoints":     atracker.DataSourceIBMAtrackerEndpoints(),
+			"ibm_atracker_target_status": atracker.DataSourceIBMAtrackerTargetStatus(),
 
 			//Security and Compliance Center
 			"ibm_scc_account_location":              scc.DataSourceIBMSccAccountLocation(),
@@ -707,8 +724,9 @@ func Provider() *schema.Provider {
 			"ibm_scc_posture_credentials":       scc.DataSourceIBMSccPostureCredentials(),
 			"ibm_scc_posture_collectors":        scc.DataSourceIBMSccPostureCollectors(),
 			// // Added for Context Based Restrictions
-			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":            contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_rule":            contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_rule_evaluation": contextbasedrestrictions.DataSourceIBMCbrRuleEvaluation(),
 
 			// // Added for Event Notifications
 			"ibm_en_source":                 eventnotification.DataSourceIBMEnSource(),
@@ -726,18 +744,18 @@ func Provider() *schema.Provider {
 			"ibm_en_subscription_email":     eventnotification.DataSourceIBMEnEmailSubscription(),
 			"ibm_en_subscription_webhook":   eventnotification.DataSourceIBMEnWebhookSubscription(),
 			"ibm_en_subscription_android":   eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_ios":       eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_chrome":    eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_firefox":   eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_ios":       eventnotification.DataSourceIBMEnAPNSSubscription(),
+			"ibm_en_subscription_chrome":    eventnotification.DataSourceIBMEnChromeSubscription(),
+			"ibm_en_subscription_firefox":   eventnotification.DataSourceIBMEnFirefoxSubscription(),
 			"ibm_en_subscription_slack":     eventnotification.DataSourceIBMEnSlackSubscription(),
-			"ibm_en_subscription_safari":    eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_safari":    eventnotification.DataSourceIBMEnSafariSubscription(),
 			"ibm_en_destination_safari":     eventnotification.DataSourceIBMEnSafariDestination(),
 			"ibm_en_destination_msteams":    eventnotification.DataSourceIBMEnMSTeamsDestination(),
-			"ibm_en_subscription_msteams":   eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_msteams":   eventnotification.DataSourceIBMEnMSTeamsSubscription(),
 			"ibm_en_destination_cf":         eventnotification.DataSourceIBMEnCFDestination(),
-			"ibm_en_subscription_cf":        eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_cf":        eventnotification.DataSourceIBMEnCFSubscription(),
 			"ibm_en_destination_pagerduty":  eventnotification.DataSourceIBMEnPagerDutyDestination(),
-			"ibm_en_subscription_pagerduty": eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_pagerduty": eventnotification.DataSourceIBMEnPagerDutySubscription(),
 			"ibm_en_integration":            eventnotification.DataSourceIBMEnIntegration(),
 			"ibm_en_integrations":           eventnotification.DataSourceIBMEnIntegrations(),
 
@@ -825,6 +843,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_certificate_upload":                cis.ResourceIBMCISCertificateUpload(),
 			"ibm_cis_dns_record":                        cis.ResourceIBMCISDnsRecord(),
 			"ibm_cis_dns_records_import":                cis.ResourceIBMCISDNSRecordsImport(),
+			"ibm_cis_dns_record_set":                    cis.ResourceIBMCISDNSRecordSet(),
 			"ibm_cis_rate_limit":                        cis.ResourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                         cis.ResourceIBMCISPageRule(),
 			"ibm_cis_edge_functions_action":             cis.ResourceIBMCISEdgeFunctionsAction(),
@@ -840,11 +859,13 @@ func Provider() *schema.Provider {
 			"ibm_cis_routing":                           cis.ResourceIBMCISRouting(),
 			"ibm_cis_waf_group":                         cis.ResourceIBMCISWAFGroup(),
 			"ibm_cis_cache_settings":                    cis.ResourceIBMCISCacheSettings(),
+			"ibm_cis_cache_purge":                       cis.ResourceIBMCISCachePurge(),
 			"ibm_cis_custom_page":                       cis.ResourceIBMCISCustomPage(),
 			"ibm_cis_waf_rule":                          cis.ResourceIBMCISWAFRule(),
 			"ibm_cis_certificate_order":                 cis.ResourceIBMCISCertificateOrder(),
 			"ibm_cis_filter":                            cis.ResourceIBMCISFilter(),
 			"ibm_cis_firewall_rule":                     cis.ResourceIBMCISFirewallrules(),
+			"ibm_cis_dnssec":                            cis.ResourceIBMCISDNSSEC(),
 			"ibm_cloudant":                              cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                     cloudant.ResourceIBMCloudantDatabase(),
 			"ibm_cloud_shell_account_settings":          cloudshell.ResourceIBMCloudShellAccountSettings(),
@@ -881,6 +902,8 @@ func Provider() *schema.Provider {
 			"ibm_container_dedicated_host":              kubernetes.ResourceIBMContainerDedicatedHost(),
 			"ibm_cr_namespace":                          registry.ResourceIBMCrNamespace(),
 			"ibm_cr_retention_policy":                   registry.ResourceIBMCrRetentionPolicy(),
+			"ibm_cr_image_tag":                          registry.ResourceIBMCrImageTag(),
+			"ibm_cr_account_settings":                   registry.ResourceIBMCrAccountSettings(),
 			"ibm_ob_logging":                            kubernetes.ResourceIBMObLogging(),
 			"ibm_ob_monitoring":                         kubernetes.ResourceIBMObMonitoring(),
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
@@ -892,6 +915,8 @@ func Provider() *schema.Provider {
 			"ibm_dns_record":                            classicinfrastructure.ResourceIBMDNSRecord(),
 			"ibm_event_streams_topic":                   eventstreams.ResourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":                  eventstreams.ResourceIBMEventStreamsSchema(),
+			"ibm_event_streams_quota":                   eventstreams.ResourceIBMEventStreamsQuota(),
+			"ibm_event_streams_mirroring_config":        eventstreams.ResourceIBMEventStreamsMirroringConfig(),
 			"ibm_firewall":                              classicinfrastructure.ResourceIBMFirewall(),
 			"ibm_firewall_policy":                       classicinfrastructure.ResourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                  hpcs.ResourceIBMHPCS(),
@@ -1063,6 +1088,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
 			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
+			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
 
 			// //Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1079,10 +1105,12 @@ func Provider() *schema.Provider {
 			"ibm_dns_custom_resolver_secondary_zone":  dnsservices.ResourceIBMPrivateDNSSecondaryZone(),
 
 			// //Direct Link related resources
-			"ibm_dl_gateway":            directlink.ResourceIBMDLGateway(),
-			"ibm_dl_virtual_connection": directlink.ResourceIBMDLGatewayVC(),
-			"ibm_dl_provider_gateway":   directlink.ResourceIBMDLProviderGateway(),
-			"ibm_dl_route_report":       directlink.ResourceIBMDLGatewayRouteReport(),
+			"ibm_dl_gateway":                     directlink.ResourceIBMDLGateway(),
+			"ibm_dl_virtual_connection":          directlink.ResourceIBMDLGatewayVC(),
+			"ibm_dl_provider_gateway":            directlink.ResourceIBMDLProviderGateway(),
+			"ibm_dl_route_report":                directlink.ResourceIBMDLGatewayRouteReport(),
+			"ibm_dl_gateway_import_route_filter": directlink.ResourceIBMDLGatewayImportRouteFilter(),
+			"ibm_dl_gateway_export_route_filter": directlink.ResourceIBMDLGatewayExportRouteFilter(),
 			// //Added for Transit Gateway
 			"ibm_tg_gateway":                  transitgateway.ResourceIBMTransitGateway(),
 			"ibm_tg_connection":               transitgateway.ResourceIBMTransitGatewayConnection(),
@@ -1103,17 +1131,22 @@ func Provider() *schema.Provider {
 			"ibm_enterprise_account":       enterprise.ResourceIBMEnterpriseAccount(),
 
 			//Added for Schematics
-			"ibm_schematics_workspace":      schematics.ResourceIBMSchematicsWorkspace(),
-			"ibm_schematics_action":         schematics.ResourceIBMSchematicsAction(),
-			"ibm_schematics_job":            schematics.ResourceIBMSchematicsJob(),
-			"ibm_schematics_inventory":      schematics.ResourceIBMSchematicsInventory(),
-			"ibm_schematics_resource_query": schematics.ResourceIBMSchematicsResourceQuery(),
+			"ibm_schematics_workspace":       schematics.ResourceIBMSchematicsWorkspace(),
+			"ibm_schematics_action":          schematics.ResourceIBMSchematicsAction(),
+			"ibm_schematics_job":             schematics.ResourceIBMSchematicsJob(),
+			"ibm_schematics_inventory":       schematics.ResourceIBMSchematicsInventory(),
+			"ibm_schematics_resource_query":  schematics.ResourceIBMSchematicsResourceQuery(),
+			"ibm_schematics_workspace_apply": schematics.ResourceIBMSchematicsWorkspaceApply(),
+			"ibm_schematics_agent":           schematics.ResourceIBMSchematicsAgent(),
+			"ibm_schematics_agent_prs":       schematics.ResourceIBMSchematicsAgentPrs(),
+			"ibm_schematics_agent_deploy":    schematics.ResourceIBMSchematicsAgentDeploy(),
 
 			// //Added for Secrets Manager
 			"ibm_sm_secret_group":                                                secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretGroup()),
 			"ibm_sm_arbitrary_secret":                                            secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmArbitrarySecret()),
 			"ibm_sm_imported_certificate":                                        secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmImportedCertificate()),
 			"ibm_sm_public_certificate":                                          secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificate()),
+			"ibm_sm_certificate_manager_migration":                               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmCertificateManagerMigration()),
 			"ibm_sm_private_certificate":                                         secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificate()),
 			"ibm_sm_iam_credentials_secret":                                      secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmIamCredentialsSecret()),
 			"ibm_sm_username_password_secret":                                    secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmUsernamePasswordSecret()),
@@ -1136,9 +1169,12 @@ func Provider() *schema.Provider {
 			"ibm_satellite_endpoint":                            satellite.ResourceIBMSatelliteEndpoint(),
 			"ibm_satellite_location_nlb_dns":                    satellite.ResourceIBMSatelliteLocationNlbDns(),
 			"ibm_satellite_cluster_worker_pool_zone_attachment": satellite.ResourceIbmSatelliteClusterWorkerPoolZoneAttachment(),
+			"ibm_satellite_storage_configuration":               satellite.ResourceIBMSatelliteStorageConfiguration(),
+			"ibm_satellite_storage_assignment":                  satellite.ResourceIBMSatelliteStorageAssignment(),
 
 			//Added for Resource Tag
 			"ibm_resource_tag": globaltagging.ResourceIBMResourceTag(),
+			"ibm_tag_policy":   globaltagging.ResourceIBMTagPolicy(),
 
 			// // Atracker
 			"ibm_atracker_target":   atracker.ResourceIBMAtrackerTarget(),
@@ -1176,18 +1212,18 @@ func Provider() *schema.Provider {
 			"ibm_en_subscription_email":     eventnotification.ResourceIBMEnEmailSubscription(),
 			"ibm_en_subscription_webhook":   eventnotification.ResourceIBMEnWebhookSubscription(),
 			"ibm_en_subscription_android":   eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_ios":       eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_chrome":    eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_firefox":   eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_ios":       eventnotification.ResourceIBMEnAPNSSubscription(),
+			"ibm_en_subscription_chrome":    eventnotification.ResourceIBMEnChromeSubscription(),
+			"ibm_en_subscription_firefox":   eventnotification.ResourceIBMEnFirefoxSubscription(),
 			"ibm_en_subscription_slack":     eventnotification.ResourceIBMEnSlackSubscription(),
-			"ibm_en_subscription_safari":    eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_safari":    eventnotification.ResourceIBMEnSafariSubscription(),
 			"ibm_en_destination_safari":     eventnotification.ResourceIBMEnSafariDestination(),
 			"ibm_en_destination_msteams":    eventnotification.ResourceIBMEnMSTeamsDestination(),
-			"ibm_en_subscription_msteams":   eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_msteams":   eventnotification.ResourceIBMEnMSTeamsSubscription(),
 			"ibm_en_destination_cf":         eventnotification.ResourceIBMEnCFDestination(),
-			"ibm_en_subscription_cf":        eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_cf":        eventnotification.ResourceIBMEnCFSubscription(),
 			"ibm_en_destination_pagerduty":  eventnotification.ResourceIBMEnPagerDutyDestination(),
-			"ibm_en_subscription_pagerduty": eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_pagerduty": eventnotification.ResourceIBMEnPagerDutySubscription(),
 			"ibm_en_integration":            eventnotification.ResourceIBMEnIntegration(),
 
 			// // Added for Toolchain
@@ -1220,6 +1256,7 @@ func Provider() *schema.Provider {
 			"ibm_cd_tekton_pipeline_property":         cdtektonpipeline.ResourceIBMCdTektonPipelineProperty(),
 			"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.ResourceIBMCdTektonPipelineTrigger(),
 			"ibm_cd_tekton_pipeline":                  cdtektonpipeline.ResourceIBMCdTektonPipeline(),
+			"ibm_cd_tekton_pipeline_run":              cdtektonpipeline.ResourceIBMCdTektonPipelineRun(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -1263,6 +1300,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_alert":                   cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":              cis.ResourceIBMCISDnsRecordValidator(),
 				"ibm_cis_dns_records_import":      cis.ResourceIBMCISDnsRecordsImportValidator(),
+				"ibm_cis_dns_record_set":          cis.ResourceIBMCISDNSRecordSetValidator(),
 				"ibm_cis_edge_functions_action":   cis.ResourceIBMCISEdgeFunctionsActionValidator(),
 				"ibm_cis_edge_functions_trigger":  cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
 				"ibm_cis_global_load_balancer":    cis.ResourceIBMCISGlbValidator(),
@@ -1271,6 +1309,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_mtls":                    cis.ResourceIBMCISMtlsValidator(),
 				"ibm_cis_origin_auth":             cis.ResourceIBMCISOriginAuthPullValidator(),
 				"ibm_cis_origin_pool":             cis.ResourceIBMCISPoolValidator(),
+				"ibm_cis_dnssec":                  cis.ResourceIBMCISDNSSECValidator(),
+				"ibm_cis_cache_purge":             cis.ResourceIBMCISCachePurgeValidator(),
 				"ibm_container_cluster":           kubernetes.ResourceIBMContainerClusterValidator(),
 				"ibm_container_worker_pool":       kubernetes.ResourceIBMContainerWorkerPoolValidator(),
 				"ibm_container_vpc_worker_pool":   kubernetes.ResourceIBMContainerVPCWorkerPoolValidator(),
@@ -1285,6 +1325,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_dl_virtual_connection":       directlink.ResourceIBMDLGatewayVCValidator(),
 				"ibm_dl_gateway":                  directlink.ResourceIBMDLGatewayValidator(),
 				"ibm_dl_provider_gateway":         directlink.ResourceIBMDLProviderGatewayValidator(),
+				"ibm_dl_gateway_route_filter":     directlink.ResourceIBMDLGatewayRouteFilterValidator(),
 				"ibm_database":                    database.ResourceIBMICDValidator(),
 				"ibm_function_package":            functions.ResourceIBMFuncPackageValidator(),
 				"ibm_function_action":             functions.ResourceIBMFuncActionValidator(),
@@ -1359,10 +1400,12 @@ func Validator() validate.ValidatorDict {
 				"ibm_schematics_workspace":                 schematics.ResourceIBMSchematicsWorkspaceValidator(),
 				"ibm_schematics_inventory":                 schematics.ResourceIBMSchematicsInventoryValidator(),
 				"ibm_schematics_resource_query":            schematics.ResourceIBMSchematicsResourceQueryValidator(),
+				"ibm_schematics_agent":                     schematics.ResourceIBMSchematicsAgentValidator(),
 				"ibm_resource_instance":                    resourcecontroller.ResourceIBMResourceInstanceValidator(),
 				"ibm_resource_key":                         resourcecontroller.ResourceIBMResourceKeyValidator(),
 				"ibm_is_virtual_endpoint_gateway":          vpc.ResourceIBMISEndpointGatewayValidator(),
 				"ibm_resource_tag":                         globaltagging.ResourceIBMResourceTagValidator(),
+				"ibm_tag_policy":                           globaltagging.ResourceIBMTagPolicyValidator(),
 				"ibm_satellite_location":                   satellite.ResourceIBMSatelliteLocationValidator(),
 				"ibm_satellite_cluster":                    satellite.ResourceIBMSatelliteClusterValidator(),
 				"ibm_pi_volume":                            power.ResourceIBMPIVolumeValidator(),
@@ -1412,6 +1455,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cd_tekton_pipeline_trigger_property": cdtektonpipeline.ResourceIBMCdTektonPipelineTriggerPropertyValidator(),
 				"ibm_cd_tekton_pipeline_property":         cdtektonpipeline.ResourceIBMCdTektonPipelinePropertyValidator(),
 				"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.ResourceIBMCdTektonPipelineTriggerValidator(),
+				"ibm_cd_tekton_pipeline_run":              cdtektonpipeline.ResourceIBMCdTektonPipelineRunValidator(),
 
 				"ibm_container_addons":                      kubernetes.ResourceIBMContainerAddOnsValidator(),
 				"ibm_container_alb_create":                  kubernetes.ResourceIBMContainerAlbCreateValidator(),
@@ -1464,6 +1508,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_custom_certificates":     cis.DataSourceIBMCISCustomCertificatesValidator(),
 				"ibm_cis_custom_pages":            cis.DataSourceIBMCISCustomPagesValidator(),
 				"ibm_cis_dns_records":             cis.DataSourceIBMCISDNSRecordsValidator(),
+				"ibm_cis_dns_zone_file":           cis.DataSourceIBMCISDNSZoneFileValidator(),
 				"ibm_cis_domain":                  cis.DataSourceIBMCISDomainValidator(),
 				"ibm_cis_certificates":            cis.DataSourceIBMCISCertificatesValidator(),
 				"ibm_cis_edge_functions_actions":  cis.DataSourceIBMCISEdgeFunctionsActionsValidator(),
@@ -1484,6 +1529,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_waf_packages":            cis.DataSourceIBMCISWAFPackagesValidator(),
 				"ibm_cis_waf_rules":               cis.DataSourceIBMCISWAFRulesValidator(),
 				"ibm_cis_logpush_jobs":            cis.DataSourceIBMCISLogPushJobsValidator(),
+				"ibm_cis_dnssec":                  cis.DataSourceIBMCISDNSSECValidator(),
 
 				"ibm_cos_bucket": cos.DataSourceIBMCosBucketValidator(),
 
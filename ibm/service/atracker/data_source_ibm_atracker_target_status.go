@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package atracker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+)
+
+func DataSourceIBMAtrackerTargetStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMAtrackerTargetStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"target_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reports the write status of the target with this ID only.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reports the write status of the targets in this region only.",
+			},
+			"targets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the last write attempt to each target. The API does not keep a history of write attempts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uuid of the target resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the target resource.",
+						},
+						"target_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the target.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the target.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the last write attempt to the target, such as failed or success.",
+						},
+						"last_failure": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The timestamp of the last failed write attempt to the target.",
+						},
+						"reason_for_last_failure": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Detailed description of the cause of the last failed write attempt.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The timestamp of the target last updated time.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMAtrackerTargetStatusRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, atrackerClient, err := getAtrackerClients(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listTargetsOptions := &atrackerv2.ListTargetsOptions{}
+	if region, ok := d.GetOk("region"); ok {
+		listTargetsOptions.SetRegion(region.(string))
+	}
+
+	targetList, response, err := atrackerClient.ListTargetsWithContext(context, listTargetsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, response))
+	}
+
+	targetID, filterTarget := d.GetOk("target_id")
+	targets := []map[string]interface{}{}
+	for _, target := range targetList.Targets {
+		if filterTarget && *target.ID != targetID.(string) {
+			continue
+		}
+		targetMap := map[string]interface{}{
+			"id":          target.ID,
+			"name":        target.Name,
+			"target_type": target.TargetType,
+			"region":      target.Region,
+			"updated_at":  flex.DateTimeToString(target.UpdatedAt),
+		}
+		if target.WriteStatus != nil {
+			targetMap["status"] = target.WriteStatus.Status
+			if target.WriteStatus.LastFailure != nil {
+				targetMap["last_failure"] = target.WriteStatus.LastFailure.String()
+			}
+			targetMap["reason_for_last_failure"] = target.WriteStatus.ReasonForLastFailure
+		}
+		targets = append(targets, targetMap)
+	}
+	if filterTarget && len(targets) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] No target found with ID %s", targetID))
+	}
+
+	if filterTarget {
+		d.SetId(targetID.(string))
+	} else {
+		d.SetId(time.Now().UTC().String())
+	}
+	if err = d.Set("targets", targets); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting targets %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package atracker_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMAtrackerTargetStatusDataSourceBasic(t *testing.T) {
+	targetName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetStatusDataSourceConfigBasic(targetName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_atracker_target_status.atracker_target_status", "id"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_target_status.atracker_target_status", "targets.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_target_status.atracker_target_status", "targets.0.name", targetName),
+					resource.TestCheckResourceAttr("data.ibm_atracker_target_status.atracker_target_status", "targets.0.target_type", "cloud_logs"),
+					resource.TestCheckResourceAttrSet("data.ibm_atracker_target_status.atracker_target_status", "targets.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerTargetStatusDataSourceConfigBasic(targetName string) string {
+	return fmt.Sprintf(`
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "cloud_logs"
+			cloudlogs_endpoint {
+				target_crn = "crn:v1:bluemix:public:logs:us-south:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+			}
+		}
+
+		data "ibm_atracker_target_status" "atracker_target_status" {
+			target_id = ibm_atracker_target.atracker_target.id
+		}
+	`, targetName)
+}
@@ -5,6 +5,7 @@ package atracker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -20,6 +21,46 @@ import (
 
 const COS_CRN_PARTS = 8
 
+// atrackerTargetEndpoints maps each target type to the endpoint it requires.
+var atrackerTargetEndpoints = map[string]string{
+	"cloud_object_storage": "cos_endpoint",
+	"logdna":               "logdna_endpoint",
+	"event_streams":        "eventstreams_endpoint",
+	"cloud_logs":           "cloudlogs_endpoint",
+}
+
+// atrackerTargetPrototype is the body of the target create and replace
+// requests. The SDK models neither Cloud Logs targets nor service to service
+// authentication for Event Streams, so the requests are sent directly.
+type atrackerTargetPrototype struct {
+	Name                 *string                             `json:"name,omitempty"`
+	TargetType           *string                             `json:"target_type,omitempty"`
+	CosEndpoint          *atrackerv2.CosEndpointPrototype    `json:"cos_endpoint,omitempty"`
+	LogdnaEndpoint       *atrackerv2.LogdnaEndpointPrototype `json:"logdna_endpoint,omitempty"`
+	EventstreamsEndpoint *atrackerEventstreamsEndpoint       `json:"eventstreams_endpoint,omitempty"`
+	CloudlogsEndpoint    *atrackerCloudlogsEndpoint          `json:"cloudlogs_endpoint,omitempty"`
+	Region               *string                             `json:"region,omitempty"`
+}
+
+type atrackerEventstreamsEndpoint struct {
+	TargetCRN               *string  `json:"target_crn"`
+	Brokers                 []string `json:"brokers"`
+	Topic                   *string  `json:"topic"`
+	APIKey                  *string  `json:"api_key,omitempty"`
+	ServiceToServiceEnabled *bool    `json:"service_to_service_enabled,omitempty"`
+}
+
+type atrackerCloudlogsEndpoint struct {
+	TargetCRN *string `json:"target_crn"`
+}
+
+// atrackerTarget is a target with the fields the SDK does not model.
+type atrackerTarget struct {
+	*atrackerv2.Target
+	EventstreamsEndpoint *atrackerEventstreamsEndpoint
+	CloudlogsEndpoint    *atrackerCloudlogsEndpoint
+}
+
 func ResourceIBMAtrackerTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMAtrackerTargetCreate,
@@ -41,7 +82,7 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				ValidateFunc:     validate.InvokeValidator("ibm_atracker_target", "target_type"),
-				Description:      "The type of the target. It can be cloud_object_storage, logdna or event_streams. Based on this type you must include cos_endpoint, logdna_endpoint or eventstreams_endpoint.",
+				Description:      "The type of the target. It can be cloud_object_storage, logdna, event_streams or cloud_logs. Based on this type you must include cos_endpoint, logdna_endpoint, eventstreams_endpoint or cloudlogs_endpoint.",
 			},
 			"cos_endpoint": {
 				Type:        schema.TypeList,
@@ -127,10 +168,31 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 						},
 						"api_key": &schema.Schema{ // pragma: allowlist secret
 							Type:             schema.TypeString,
-							Required:         true,
+							Optional:         true,
 							Sensitive:        true,
 							DiffSuppressFunc: flex.ApplyOnce,
-							Description:      "The user password (api key) for the message hub topic in the Event Streams instance.",
+							Description:      "The user password (api key) for the message hub topic in the Event Streams instance. This is required if service_to_service is not enabled.",
+						},
+						"service_to_service_enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "ATracker service is enabled to support service to service authentication. If service to service is enabled then set this flag is true and do not supply apikey.",
+						},
+					},
+				},
+			},
+			"cloudlogs_endpoint": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Property values for the IBM Cloud Logs endpoint in requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the IBM Cloud Logs instance.",
 						},
 					},
 				},
@@ -255,7 +317,7 @@ func ResourceIBMAtrackerTargetValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              "cloud_object_storage, logdna, event_streams",
+			AllowedValues:              "cloud_object_storage, logdna, event_streams, cloud_logs",
 		},
 		validate.ValidateSchema{
 			Identifier:                 "region",
@@ -278,36 +340,43 @@ func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	createTargetOptions := &atrackerv2.CreateTargetOptions{}
+	if err = resourceIBMAtrackerTargetCheckEndpoint(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	createTarget := &atrackerTargetPrototype{}
 
-	createTargetOptions.SetName(d.Get("name").(string))
-	createTargetOptions.SetTargetType(d.Get("target_type").(string))
+	createTarget.Name = core.StringPtr(d.Get("name").(string))
+	createTarget.TargetType = core.StringPtr(d.Get("target_type").(string))
 	if _, ok := d.GetOk("cos_endpoint"); ok {
 		cosEndpointModel, err := resourceIBMAtrackerTargetMapToCosEndpointPrototype(d.Get("cos_endpoint.0").(map[string]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		createTargetOptions.SetCosEndpoint(cosEndpointModel)
+		createTarget.CosEndpoint = cosEndpointModel
 	}
 	if _, ok := d.GetOk("logdna_endpoint"); ok {
 		logdnaEndpointModel, err := resourceIBMAtrackerTargetMapToLogdnaEndpointPrototype(d.Get("logdna_endpoint.0").(map[string]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		createTargetOptions.SetLogdnaEndpoint(logdnaEndpointModel)
+		createTarget.LogdnaEndpoint = logdnaEndpointModel
 	}
 	if _, ok := d.GetOk("eventstreams_endpoint"); ok {
 		eventstreamsEndpointModel, err := resourceIBMAtrackerTargetMapToEventstreamsEndpointPrototype(d.Get("eventstreams_endpoint.0").(map[string]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		createTargetOptions.SetEventstreamsEndpoint(eventstreamsEndpointModel)
+		createTarget.EventstreamsEndpoint = eventstreamsEndpointModel
+	}
+	if _, ok := d.GetOk("cloudlogs_endpoint"); ok {
+		createTarget.CloudlogsEndpoint = resourceIBMAtrackerTargetMapToCloudlogsEndpointPrototype(d.Get("cloudlogs_endpoint.0").(map[string]interface{}))
 	}
 	if _, ok := d.GetOk("region"); ok {
-		createTargetOptions.SetRegion(d.Get("region").(string))
+		createTarget.Region = core.StringPtr(d.Get("region").(string))
 	}
 
-	target, response, err := atrackerClient.CreateTargetWithContext(context, createTargetOptions)
+	target, response, err := atrackerTargetRequest(context, atrackerClient, core.POST, "", createTarget)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTargetWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
@@ -324,11 +393,7 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	getTargetOptions := &atrackerv2.GetTargetOptions{}
-
-	getTargetOptions.SetID(d.Id())
-
-	target, response, err := atrackerClient.GetTargetWithContext(context, getTargetOptions)
+	target, response, err := atrackerTargetRequest(context, atrackerClient, core.GET, d.Id(), nil)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -389,6 +454,14 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 			return diag.FromErr(fmt.Errorf("Error setting eventstreams_endpoint: %s", err))
 		}
 	}
+	if target.CloudlogsEndpoint != nil {
+		cloudlogsEndpointMap := map[string]interface{}{
+			"target_crn": target.CloudlogsEndpoint.TargetCRN,
+		}
+		if err = d.Set("cloudlogs_endpoint", []map[string]interface{}{cloudlogsEndpointMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting cloudlogs_endpoint: %s", err))
+		}
+	}
 
 	if target.CRN != nil {
 		if err = d.Set("crn", target.CRN); err != nil {
@@ -437,14 +510,15 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	replaceTargetOptions := &atrackerv2.ReplaceTargetOptions{}
-
-	replaceTargetOptions.SetID(d.Id())
+	replaceTarget := &atrackerTargetPrototype{}
 
 	hasChange := false
 
-	if d.HasChange("name") || d.HasChange("cos_endpoint") || d.HasChange("region") || d.HasChange("logdna_endpoint") || d.HasChange("eventstreams_endpoint") {
-		replaceTargetOptions.SetName(d.Get("name").(string))
+	if d.HasChange("name") || d.HasChange("cos_endpoint") || d.HasChange("region") || d.HasChange("logdna_endpoint") || d.HasChange("eventstreams_endpoint") || d.HasChange("cloudlogs_endpoint") {
+		if err = resourceIBMAtrackerTargetCheckEndpoint(d); err != nil {
+			return diag.FromErr(err)
+		}
+		replaceTarget.Name = core.StringPtr(d.Get("name").(string))
 
 		_, hasCosEndpoint := d.GetOk("cos_endpoint.0")
 		if hasCosEndpoint {
@@ -452,7 +526,7 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			replaceTargetOptions.SetCosEndpoint(cosEndpoint)
+			replaceTarget.CosEndpoint = cosEndpoint
 		}
 
 		_, hasLogDNAEndpoint := d.GetOk("logdna_endpoint.0")
@@ -461,7 +535,7 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			replaceTargetOptions.SetLogdnaEndpoint(logdnaEndpoint)
+			replaceTarget.LogdnaEndpoint = logdnaEndpoint
 		}
 		_, hasEventstreamsEndpoint := d.GetOk("eventstreams_endpoint.0")
 		if hasEventstreamsEndpoint {
@@ -469,14 +543,18 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			replaceTargetOptions.SetEventstreamsEndpoint(eventstreamsEndpoint)
+			replaceTarget.EventstreamsEndpoint = eventstreamsEndpoint
+		}
+		_, hasCloudlogsEndpoint := d.GetOk("cloudlogs_endpoint.0")
+		if hasCloudlogsEndpoint {
+			replaceTarget.CloudlogsEndpoint = resourceIBMAtrackerTargetMapToCloudlogsEndpointPrototype(d.Get("cloudlogs_endpoint.0").(map[string]interface{}))
 		}
 
 		hasChange = true
 	}
 
 	if hasChange {
-		_, response, err := atrackerClient.ReplaceTargetWithContext(context, replaceTargetOptions)
+		_, response, err := atrackerTargetRequest(context, atrackerClient, core.PUT, d.Id(), replaceTarget)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTargetWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("ReplaceTargetWithContext failed %s\n%s", err, response))
@@ -512,9 +590,11 @@ func resourceIBMAtrackerTargetMapToCosEndpointPrototype(modelMap map[string]inte
 	model.Endpoint = core.StringPtr(modelMap["endpoint"].(string))
 	model.TargetCRN = core.StringPtr(modelMap["target_crn"].(string))
 	model.Bucket = core.StringPtr(modelMap["bucket"].(string))
-	if modelMap["api_key"] != nil && modelMap["api_key"].(string) != "" {
-		model.APIKey = core.StringPtr(modelMap["api_key"].(string))
+	apiKey, err := atrackerEndpointAPIKey("cos_endpoint", modelMap)
+	if err != nil {
+		return nil, err
 	}
+	model.APIKey = apiKey
 	model.ServiceToServiceEnabled = core.BoolPtr(modelMap["service_to_service_enabled"].(bool))
 	return model, nil
 }
@@ -526,8 +606,8 @@ func resourceIBMAtrackerTargetMapToLogdnaEndpointPrototype(modelMap map[string]i
 	return model, nil
 }
 
-func resourceIBMAtrackerTargetMapToEventstreamsEndpointPrototype(modelMap map[string]interface{}) (*atrackerv2.EventstreamsEndpointPrototype, error) {
-	model := &atrackerv2.EventstreamsEndpointPrototype{}
+func resourceIBMAtrackerTargetMapToEventstreamsEndpointPrototype(modelMap map[string]interface{}) (*atrackerEventstreamsEndpoint, error) {
+	model := &atrackerEventstreamsEndpoint{}
 	model.TargetCRN = core.StringPtr(modelMap["target_crn"].(string))
 	model.Topic = core.StringPtr(modelMap["topic"].(string))
 	brokers := []string{}
@@ -535,10 +615,54 @@ func resourceIBMAtrackerTargetMapToEventstreamsEndpointPrototype(modelMap map[st
 		brokers = append(brokers, brokersItem.(string))
 	}
 	model.Brokers = brokers
-	model.APIKey = core.StringPtr(modelMap["api_key"].(string)) // pragma: whitelist secret
+	apiKey, err := atrackerEndpointAPIKey("eventstreams_endpoint", modelMap)
+	if err != nil {
+		return nil, err
+	}
+	model.APIKey = apiKey
+	if modelMap["service_to_service_enabled"].(bool) {
+		model.ServiceToServiceEnabled = core.BoolPtr(true)
+	}
 	return model, nil
 }
 
+func resourceIBMAtrackerTargetMapToCloudlogsEndpointPrototype(modelMap map[string]interface{}) *atrackerCloudlogsEndpoint {
+	model := &atrackerCloudlogsEndpoint{}
+	model.TargetCRN = core.StringPtr(modelMap["target_crn"].(string))
+	return model
+}
+
+// resourceIBMAtrackerTargetCheckEndpoint checks that the endpoint of the
+// target type is set.
+func resourceIBMAtrackerTargetCheckEndpoint(d *schema.ResourceData) error {
+	targetType := d.Get("target_type").(string)
+	endpoint, ok := atrackerTargetEndpoints[targetType]
+	if !ok {
+		return nil
+	}
+	if _, ok := d.GetOk(endpoint + ".0"); !ok {
+		return fmt.Errorf("[ERROR] %s is required for targets of type %s", endpoint, targetType)
+	}
+	return nil
+}
+
+// atrackerEndpointAPIKey returns the API key of an endpoint that supports
+// service to service authentication. The key is required unless service to
+// service is enabled, and is not sent when it is.
+func atrackerEndpointAPIKey(endpoint string, modelMap map[string]interface{}) (*string, error) {
+	apiKey := modelMap["api_key"].(string)
+	if modelMap["service_to_service_enabled"].(bool) {
+		if apiKey != "" && apiKey != REDACTED_TEXT {
+			return nil, fmt.Errorf("[ERROR] %s must not set api_key when service_to_service_enabled is true", endpoint)
+		}
+		return nil, nil
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("[ERROR] %s requires api_key when service_to_service_enabled is false", endpoint)
+	}
+	return core.StringPtr(apiKey), nil // pragma: whitelist secret
+}
+
 func resourceIBMAtrackerTargetCosEndpointPrototypeToMap(model *atrackerv2.CosEndpoint) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	modelMap["endpoint"] = model.Endpoint
@@ -558,13 +682,17 @@ func resourceIBMAtrackerTargetLogdnaEndpointPrototypeToMap(model *atrackerv2.Log
 	return modelMap, nil
 }
 
-func resourceIBMAtrackerTargetEventstreamsEndpointPrototypeToMap(model *atrackerv2.EventstreamsEndpoint) (map[string]interface{}, error) {
+func resourceIBMAtrackerTargetEventstreamsEndpointPrototypeToMap(model *atrackerEventstreamsEndpoint) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	modelMap["target_crn"] = model.TargetCRN
 	modelMap["brokers"] = model.Brokers
 	modelMap["topic"] = model.Topic
+	serviceToServiceEnabled := model.ServiceToServiceEnabled != nil && *model.ServiceToServiceEnabled
+	modelMap["service_to_service_enabled"] = serviceToServiceEnabled
 	// TODO: remove after deprecation
-	modelMap["api_key"] = REDACTED_TEXT // pragma: whitelist secret
+	if !serviceToServiceEnabled {
+		modelMap["api_key"] = REDACTED_TEXT // pragma: whitelist secret
+	}
 	return modelMap, nil
 }
 
@@ -579,3 +707,56 @@ func resourceIBMAtrackerTargetWriteStatusToMap(model *atrackerv2.WriteStatus) (m
 	}
 	return modelMap, nil
 }
+
+// atrackerTargetRequest creates the target when the ID is empty, and gets or
+// replaces the target with the ID otherwise.
+func atrackerTargetRequest(context context.Context, atrackerClient *atrackerv2.AtrackerV2, method string, id string, body *atrackerTargetPrototype) (*atrackerTarget, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = atrackerClient.GetEnableGzipCompression()
+	var err error
+	if id == "" {
+		_, err = builder.ResolveRequestURL(atrackerClient.Service.Options.URL, `/api/v2/targets`, nil)
+	} else {
+		_, err = builder.ResolveRequestURL(atrackerClient.Service.Options.URL, `/api/v2/targets/{id}`, map[string]string{"id": id})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err := atrackerClient.Service.Request(request, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+
+	target := &atrackerTarget{}
+	err = core.UnmarshalModel(rawResponse, "", &target.Target, atrackerv2.UnmarshalTarget)
+	if err != nil {
+		return nil, response, err
+	}
+	if rawEndpoint, ok := rawResponse["eventstreams_endpoint"]; ok {
+		if err = json.Unmarshal(rawEndpoint, &target.EventstreamsEndpoint); err != nil {
+			return nil, response, err
+		}
+	}
+	if rawEndpoint, ok := rawResponse["cloudlogs_endpoint"]; ok {
+		if err = json.Unmarshal(rawEndpoint, &target.CloudlogsEndpoint); err != nil {
+			return nil, response, err
+		}
+	}
+	return target, response, nil
+}
@@ -51,6 +51,91 @@ func TestAccIBMAtrackerTargetBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMAtrackerTargetEventStreamsServiceToService(t *testing.T) {
+	var conf atrackerv2.Target
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigEventStreamsServiceToService(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMAtrackerTargetExists("ibm_atracker_target.atracker_target", conf),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", name),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "target_type", "event_streams"),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "eventstreams_endpoint.0.service_to_service_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMAtrackerTargetCloudLogs(t *testing.T) {
+	var conf atrackerv2.Target
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigCloudLogs(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMAtrackerTargetExists("ibm_atracker_target.atracker_target", conf),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", name),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "target_type", "cloud_logs"),
+					resource.TestCheckResourceAttrSet("ibm_atracker_target.atracker_target", "cloudlogs_endpoint.0.target_crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigCloudLogs(nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_atracker_target.atracker_target",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerTargetConfigEventStreamsServiceToService(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "event_streams"
+			eventstreams_endpoint {
+				target_crn = "crn:v1:bluemix:public:messagehub:us-south:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				brokers = [ "kafka-x:9094" ]
+				topic = "my-topic"
+				service_to_service_enabled = true
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMAtrackerTargetConfigCloudLogs(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "cloud_logs"
+			cloudlogs_endpoint {
+				target_crn = "crn:v1:bluemix:public:logs:us-south:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+			}
+		}
+	`, name)
+}
+
 func testAccCheckIBMAtrackerTargetConfigBasic(name string, targetType string) string {
 	return fmt.Sprintf(`
 
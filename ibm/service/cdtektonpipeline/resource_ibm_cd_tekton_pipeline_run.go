@@ -0,0 +1,390 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMCdTektonPipelineRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCdTektonPipelineRunCreate,
+		ReadContext:   resourceIBMCdTektonPipelineRunRead,
+		DeleteContext: resourceIBMCdTektonPipelineRunDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_run", "pipeline_id"),
+				Description:  "The Tekton pipeline ID.",
+			},
+			"trigger_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_trigger", "name"),
+				Description:  "Name of the manual trigger that starts the run.",
+			},
+			"trigger_properties": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Text properties that override the pipeline and trigger properties for this run.",
+			},
+			"secure_trigger_properties": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Secure properties that override the pipeline and trigger properties for this run.",
+			},
+			"wait_for_completion": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether to wait until the run succeeds or fails. When false the resource is created as soon as the run is queued.",
+			},
+			"log_tail_lines": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      100,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_run", "log_tail_lines"),
+				Description:  "Number of lines kept from the end of the task logs of the run.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the pipeline run.",
+			},
+			"run_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL for the details page of this pipeline run.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API URL for interacting with the pipeline run.",
+			},
+			"definition_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the definition used for the pipeline run.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Standard RFC 3339 Date Time String.",
+			},
+			"logs": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The tail of the task logs of the pipeline run, collected when the run finished.",
+			},
+		},
+	}
+}
+
+func ResourceIBMCdTektonPipelineRunValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "pipeline_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[-0-9a-z]+$`,
+			MinValueLength:             36,
+			MaxValueLength:             36,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "log_tail_lines",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "10000",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cd_tekton_pipeline_run", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCdTektonPipelineRunCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createTektonPipelineRunOptions := &cdtektonpipelinev2.CreateTektonPipelineRunOptions{}
+
+	createTektonPipelineRunOptions.SetPipelineID(d.Get("pipeline_id").(string))
+	trigger := &cdtektonpipelinev2.PipelineRunTrigger{
+		Name: core.StringPtr(d.Get("trigger_name").(string)),
+	}
+	if v, ok := d.GetOk("trigger_properties"); ok {
+		trigger.Properties = expandCdTektonPipelineRunProperties(v.(map[string]interface{}), "text")
+	}
+	if v, ok := d.GetOk("secure_trigger_properties"); ok {
+		trigger.SecureProperties = expandCdTektonPipelineRunProperties(v.(map[string]interface{}), "secure")
+	}
+	createTektonPipelineRunOptions.Trigger = trigger
+
+	pipelineRun, response, err := cdTektonPipelineClient.CreateTektonPipelineRunWithContext(context, createTektonPipelineRunOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateTektonPipelineRunWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateTektonPipelineRunWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelineRunOptions.PipelineID, *pipelineRun.ID))
+
+	if d.Get("wait_for_completion").(bool) {
+		run, err := waitForCdTektonPipelineRunFinished(context, cdTektonPipelineClient, *createTektonPipelineRunOptions.PipelineID, *pipelineRun.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		pipelineRun = run.(*cdtektonpipelinev2.PipelineRun)
+
+		logs, err := getCdTektonPipelineRunLogTail(context, cdTektonPipelineClient, *pipelineRun.PipelineID, *pipelineRun.ID, d.Get("log_tail_lines").(int))
+		if err != nil {
+			log.Printf("[WARN] Unable to retrieve the logs of pipeline run %s: %s", d.Id(), err)
+		}
+		if err = d.Set("logs", logs); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting logs: %s", err))
+		}
+
+		if *pipelineRun.Status != cdtektonpipelinev2.PipelineRunStatusSucceededConst {
+			resourceIBMCdTektonPipelineRunRead(context, d, meta)
+			return diag.FromErr(fmt.Errorf("Pipeline run %s finished with status %s, see %s\n%s", *pipelineRun.ID, *pipelineRun.Status, core.StringNilMapper(pipelineRun.RunURL), logs))
+		}
+	}
+
+	return resourceIBMCdTektonPipelineRunRead(context, d, meta)
+}
+
+func resourceIBMCdTektonPipelineRunRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getTektonPipelineRunOptions := &cdtektonpipelinev2.GetTektonPipelineRunOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getTektonPipelineRunOptions.SetPipelineID(parts[0])
+	getTektonPipelineRunOptions.SetID(parts[1])
+
+	pipelineRun, response, err := cdTektonPipelineClient.GetTektonPipelineRunWithContext(context, getTektonPipelineRunOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetTektonPipelineRunWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetTektonPipelineRunWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("pipeline_id", pipelineRun.PipelineID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting pipeline_id: %s", err))
+	}
+	if trigger, ok := pipelineRun.Trigger.(*cdtektonpipelinev2.Trigger); ok && trigger.Name != nil {
+		if err = d.Set("trigger_name", trigger.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting trigger_name: %s", err))
+		}
+	}
+	if _, ok := d.GetOk("wait_for_completion"); !ok {
+		d.Set("wait_for_completion", true)
+	}
+	if _, ok := d.GetOk("log_tail_lines"); !ok {
+		d.Set("log_tail_lines", 100)
+	}
+	if err = d.Set("status", pipelineRun.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("run_url", pipelineRun.RunURL); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting run_url: %s", err))
+	}
+	if err = d.Set("href", pipelineRun.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("definition_id", pipelineRun.DefinitionID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting definition_id: %s", err))
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(pipelineRun.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCdTektonPipelineRunDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A run that is still in progress can't be deleted, so it's cancelled first.
+	if status := d.Get("status").(string); !isCdTektonPipelineRunFinished(status) {
+		cancelTektonPipelineRunOptions := &cdtektonpipelinev2.CancelTektonPipelineRunOptions{}
+		cancelTektonPipelineRunOptions.SetPipelineID(parts[0])
+		cancelTektonPipelineRunOptions.SetID(parts[1])
+		_, response, err := cdTektonPipelineClient.CancelTektonPipelineRunWithContext(context, cancelTektonPipelineRunOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] CancelTektonPipelineRunWithContext failed %s\n%s", err, response)
+		}
+		_, err = waitForCdTektonPipelineRunFinished(context, cdTektonPipelineClient, parts[0], parts[1], d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	deleteTektonPipelineRunOptions := &cdtektonpipelinev2.DeleteTektonPipelineRunOptions{}
+
+	deleteTektonPipelineRunOptions.SetPipelineID(parts[0])
+	deleteTektonPipelineRunOptions.SetID(parts[1])
+
+	response, err := cdTektonPipelineClient.DeleteTektonPipelineRunWithContext(context, deleteTektonPipelineRunOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteTektonPipelineRunWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineRunWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func isCdTektonPipelineRunFinished(status string) bool {
+	switch status {
+	case cdtektonpipelinev2.PipelineRunStatusSucceededConst,
+		cdtektonpipelinev2.PipelineRunStatusFailedConst,
+		cdtektonpipelinev2.PipelineRunStatusErrorConst,
+		cdtektonpipelinev2.PipelineRunStatusCancelledConst:
+		return true
+	}
+	return false
+}
+
+func waitForCdTektonPipelineRunFinished(context context.Context, cdTektonPipelineClient *cdtektonpipelinev2.CdTektonPipelineV2, pipelineID string, runID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cdtektonpipelinev2.PipelineRunStatusPendingConst,
+			cdtektonpipelinev2.PipelineRunStatusWaitingConst,
+			cdtektonpipelinev2.PipelineRunStatusQueuedConst,
+			cdtektonpipelinev2.PipelineRunStatusRunningConst,
+			cdtektonpipelinev2.PipelineRunStatusCancellingConst,
+		},
+		Target: []string{
+			cdtektonpipelinev2.PipelineRunStatusSucceededConst,
+			cdtektonpipelinev2.PipelineRunStatusFailedConst,
+			cdtektonpipelinev2.PipelineRunStatusErrorConst,
+			cdtektonpipelinev2.PipelineRunStatusCancelledConst,
+		},
+		Refresh: func() (interface{}, string, error) {
+			getTektonPipelineRunOptions := &cdtektonpipelinev2.GetTektonPipelineRunOptions{}
+			getTektonPipelineRunOptions.SetPipelineID(pipelineID)
+			getTektonPipelineRunOptions.SetID(runID)
+			pipelineRun, response, err := cdTektonPipelineClient.GetTektonPipelineRunWithContext(context, getTektonPipelineRunOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetTektonPipelineRunWithContext failed %s\n%s", err, response)
+			}
+			return pipelineRun, *pipelineRun.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+// getCdTektonPipelineRunLogTail concatenates the logs of every step of the run,
+// each line prefixed with the step name, and keeps the last maxLines lines.
+func getCdTektonPipelineRunLogTail(context context.Context, cdTektonPipelineClient *cdtektonpipelinev2.CdTektonPipelineV2, pipelineID string, runID string, maxLines int) (string, error) {
+	if maxLines == 0 {
+		return "", nil
+	}
+
+	getTektonPipelineRunLogsOptions := &cdtektonpipelinev2.GetTektonPipelineRunLogsOptions{}
+	getTektonPipelineRunLogsOptions.SetPipelineID(pipelineID)
+	getTektonPipelineRunLogsOptions.SetID(runID)
+	logsCollection, response, err := cdTektonPipelineClient.GetTektonPipelineRunLogsWithContext(context, getTektonPipelineRunLogsOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetTektonPipelineRunLogsWithContext failed %s\n%s", err, response)
+	}
+
+	lines := []string{}
+	for _, stepLog := range logsCollection.Logs {
+		getTektonPipelineRunLogContentOptions := &cdtektonpipelinev2.GetTektonPipelineRunLogContentOptions{}
+		getTektonPipelineRunLogContentOptions.SetPipelineID(pipelineID)
+		getTektonPipelineRunLogContentOptions.SetPipelineRunID(runID)
+		getTektonPipelineRunLogContentOptions.SetID(*stepLog.ID)
+		content, response, err := cdTektonPipelineClient.GetTektonPipelineRunLogContentWithContext(context, getTektonPipelineRunLogContentOptions)
+		if err != nil {
+			return "", fmt.Errorf("GetTektonPipelineRunLogContentWithContext failed %s\n%s", err, response)
+		}
+		for _, line := range strings.Split(strings.TrimRight(core.StringNilMapper(content.Data), "\n"), "\n") {
+			lines = append(lines, fmt.Sprintf("[%s] %s", core.StringNilMapper(stepLog.Name), line))
+		}
+	}
+
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func expandCdTektonPipelineRunProperties(properties map[string]interface{}, propertyType string) []cdtektonpipelinev2.Property {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]cdtektonpipelinev2.Property, 0, len(properties))
+	for _, name := range names {
+		result = append(result, cdtektonpipelinev2.Property{
+			Name:  core.StringPtr(name),
+			Value: core.StringPtr(properties[name].(string)),
+			Type:  core.StringPtr(propertyType),
+		})
+	}
+	return result
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"io/ioutil"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSZoneFile = "zone_file"
+)
+
+func DataSourceIBMCISDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCISDNSZoneFileRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "DNS Zone CRN",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_dns_zone_file",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Zone Id",
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSZoneFile: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS records of the zone in BIND zone file format",
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISDNSZoneFileValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	iBMCISDNSZoneFileValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_zone_file",
+		Schema:       validateSchema}
+	return &iBMCISDNSZoneFileValidator
+}
+
+func dataSourceIBMCISDNSZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordBulkClientSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	opt := sess.NewGetDnsRecordsBulkOptions()
+	result, response, err := sess.GetDnsRecordsBulk(opt)
+	if err != nil {
+		log.Printf("Error exporting dns records: %s", response)
+		return err
+	}
+	defer result.Close()
+	buf, err := ioutil.ReadAll(result)
+	if err != nil {
+		log.Printf("Error while reading io reader")
+		return err
+	}
+
+	d.SetId(dataSourceIBMCISDNSRecordID(d))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisDNSZoneFile, string(buf))
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMCISDNSSEC() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCISDNSSECRead,
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_dnssec",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSSECStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSSEC status",
+			},
+			cisDNSSECFlags: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "DNSKEY flags",
+			},
+			cisDNSSECAlgorithm: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSSEC signing algorithm",
+			},
+			cisDNSSECKeyType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSSEC key type",
+			},
+			cisDNSSECDigestType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record digest type",
+			},
+			cisDNSSECDigestAlgorithm: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record digest algorithm",
+			},
+			cisDNSSECDigest: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record digest",
+			},
+			cisDNSSECDS: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record to add at the domain registrar",
+			},
+			cisDNSSECKeyTag: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "DNSSEC key tag",
+			},
+			cisDNSSECPublicKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSSEC public key",
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISDNSSECValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	iBMCISDNSSECValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dnssec",
+		Schema:       validateSchema}
+	return &iBMCISDNSSECValidator
+}
+
+func dataSourceIBMCISDNSSECRead(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	opt := cisClient.NewGetZoneDnssecOptions()
+	result, response, err := cisClient.GetZoneDnssec(opt)
+	if err != nil {
+		log.Printf("Get zone dnssec failed: %v", response)
+		return err
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	setCISDNSSECResult(d, result.Result)
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSSECDataSource_basic(t *testing.T) {
+	node := "data.ibm_cis_dnssec.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSSECDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "status"),
+					resource.TestCheckResourceAttrSet(node, "ds"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSSECDataSourceConfig() string {
+	return testAccCheckIBMCisDNSSECConfigBasic("active") + `
+	data "ibm_cis_dnssec" "test" {
+		cis_id    = ibm_cis_dnssec.test.cis_id
+		domain_id = ibm_cis_dnssec.test.domain_id
+	}`
+}
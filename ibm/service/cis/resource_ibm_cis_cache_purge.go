@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	cachingapiv1 "github.com/IBM/networking-go-sdk/cachingapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISCachePurge         = "ibm_cis_cache_purge"
+	cisCachePurgeActionAll   = "purge_everything"
+	cisCachePurgeURLs        = "urls"
+	cisCachePurgeHosts       = "hosts"
+	cisCachePurgeTags        = "tags"
+	cisCachePurgeTriggers    = "triggers"
+	cisCachePurgeIDs         = "purge_ids"
+	cisCachePurgeCount       = "purged_count"
+	cisCachePurgeSummary     = "summary"
+	cisCachePurgeRequestedAt = "requested_at"
+	cisCachePurgeMaxPerBatch = 30
+)
+
+func ResourceIBMCISCachePurge() *schema.Resource {
+	return &schema.Resource{
+		Create: ResourceIBMCISCachePurgeCreate,
+		Read:   ResourceIBMCISCachePurgeRead,
+		Delete: ResourceIBMCISCachePurgeDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISCachePurge,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisCachePurgeActionAll: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Description:  "Purge all cached content of the domain",
+				ExactlyOneOf: cisCachePurgeTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
+			cisCachePurgeURLs: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "URLs to purge",
+				ExactlyOneOf: cisCachePurgeTypes,
+			},
+			cisCachePurgeHosts: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Hosts to purge",
+				ExactlyOneOf: cisCachePurgeTypes,
+			},
+			cisCachePurgeTags: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Cache tags to purge",
+				ExactlyOneOf: cisCachePurgeTypes,
+			},
+			cisCachePurgeTriggers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that cause a new purge when changed",
+			},
+			cisCachePurgeIDs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Identifiers of the purge requests",
+			},
+			cisCachePurgeCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of URLs, hosts or tags purged",
+			},
+			cisCachePurgeSummary: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Summary of what was purged",
+			},
+			cisCachePurgeRequestedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the purge was requested, the purge itself runs asynchronously",
+			},
+		},
+	}
+}
+
+var cisCachePurgeTypes = []string{
+	cisCachePurgeActionAll,
+	cisCachePurgeURLs,
+	cisCachePurgeHosts,
+	cisCachePurgeTags,
+}
+
+func ResourceIBMCISCachePurgeValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	ibmCISCachePurgeValidator := validate.ResourceValidator{
+		ResourceName: ibmCISCachePurge,
+		Schema:       validateSchema}
+	return &ibmCISCachePurgeValidator
+}
+
+func ResourceIBMCISCachePurgeCreate(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisCacheClientSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneID = core.StringPtr(zoneID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	var purgeType string
+	var items []string
+	for _, t := range cisCachePurgeTypes[1:] {
+		if v, ok := d.GetOk(t); ok {
+			purgeType = t
+			items = flex.ExpandStringList(v.([]interface{}))
+		}
+	}
+
+	purgeIDs := []string{}
+	if purgeType == "" {
+		result, response, err := cisClient.PurgeAllWithContext(ctx, cisClient.NewPurgeAllOptions())
+		purgeID, err := checkCISCachePurgeResult(result, response, err)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error purging all cached content: %s", err)
+		}
+		purgeIDs = append(purgeIDs, purgeID)
+	}
+
+	for start := 0; start < len(items); start += cisCachePurgeMaxPerBatch {
+		end := start + cisCachePurgeMaxPerBatch
+		if end > len(items) {
+			end = len(items)
+		}
+		batch := items[start:end]
+
+		var result *cachingapiv1.PurgeAllResponse
+		var response *core.DetailedResponse
+		switch purgeType {
+		case cisCachePurgeURLs:
+			opt := cisClient.NewPurgeByUrlsOptions()
+			opt.SetFiles(batch)
+			result, response, err = cisClient.PurgeByUrlsWithContext(ctx, opt)
+		case cisCachePurgeHosts:
+			opt := cisClient.NewPurgeByHostsOptions()
+			opt.SetHosts(batch)
+			result, response, err = cisClient.PurgeByHostsWithContext(ctx, opt)
+		case cisCachePurgeTags:
+			opt := cisClient.NewPurgeByCacheTagsOptions()
+			opt.SetTags(batch)
+			result, response, err = cisClient.PurgeByCacheTagsWithContext(ctx, opt)
+		}
+		purgeID, err := checkCISCachePurgeResult(result, response, err)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error purging cache by %s after %d of %d purged: %s", purgeType, start, len(items), err)
+		}
+		purgeIDs = append(purgeIDs, purgeID)
+	}
+
+	summary := "purged all cached content"
+	if purgeType != "" {
+		summary = fmt.Sprintf("purged %d %s in %d requests", len(items), purgeType, len(purgeIDs))
+	}
+	log.Printf("[INFO] CIS cache of zone %s %s", zoneID, summary)
+
+	d.SetId(flex.ConvertCisToTfThreeVar(purgeIDs[0], zoneID, crn))
+	d.Set(cisCachePurgeIDs, purgeIDs)
+	d.Set(cisCachePurgeCount, len(items))
+	d.Set(cisCachePurgeSummary, summary)
+	d.Set(cisCachePurgeRequestedAt, time.Now().UTC().Format(time.RFC3339))
+
+	return ResourceIBMCISCachePurgeRead(d, meta)
+}
+
+func ResourceIBMCISCachePurgeRead(d *schema.ResourceData, meta interface{}) error {
+	// A purge is a one-time action, there is nothing to read back.
+	return nil
+}
+
+func ResourceIBMCISCachePurgeDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// checkCISCachePurgeResult returns the purge ID once the API has accepted the
+// purge request. The purge itself runs asynchronously and the API doesn't
+// report when the edge caches are cleared.
+func checkCISCachePurgeResult(result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) (string, error) {
+	if err != nil {
+		log.Printf("Purge cache failed: %v", response)
+		return "", err
+	}
+	if result == nil || result.Success == nil || !*result.Success {
+		return "", fmt.Errorf("purge request was not accepted: %v", response)
+	}
+	if result.Result == nil || result.Result.ID == nil {
+		return "", fmt.Errorf("purge response has no purge ID: %v", response)
+	}
+	return *result.Result.ID, nil
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisCachePurge_Basic(t *testing.T) {
+	name := "ibm_cis_cache_purge.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisCachePurgeConfigBasic("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "purged_count", "2"),
+					resource.TestCheckResourceAttr(name, "purge_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "summary"),
+					resource.TestCheckResourceAttrSet(name, "requested_at"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisCachePurgeConfigBasic("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "triggers.release", "2"),
+					resource.TestCheckResourceAttr(name, "purged_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCisCachePurge_All(t *testing.T) {
+	name := "ibm_cis_cache_purge.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDomainDataSourceConfigBasic1() + `
+				resource "ibm_cis_cache_purge" "test" {
+					cis_id    = data.ibm_cis.cis.id
+					domain_id = data.ibm_cis_domain.cis_domain.domain_id
+					purge_everything {}
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "summary", "purged all cached content"),
+					resource.TestCheckResourceAttr(name, "purge_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisCachePurgeConfigBasic(release string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_cache_purge" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		urls      = ["https://%[1]s/index.html", "https://%[1]s/app.js"]
+		triggers = {
+			release = "%[2]s"
+		}
+	}`, acc.CisDomainStatic, release)
+}
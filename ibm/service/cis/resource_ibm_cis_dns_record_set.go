@@ -0,0 +1,504 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSRecordSetRecords = "records"
+	cisDNSRecordSetCount   = "record_count"
+)
+
+func ResourceIBMCISDNSRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCISDNSRecordSetCreate,
+		Read:     resourceIBMCISDNSRecordSetRead,
+		Update:   resourceIBMCISDNSRecordSetUpdate,
+		Delete:   resourceIBMCISDNSRecordSetDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_dns_record_set",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSRecordType: {
+				Type:        schema.TypeString,
+				Description: "Type of the records owned by the record set",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_dns_record_set",
+					cisDNSRecordType),
+			},
+			cisDNSRecordName: {
+				Type:        schema.TypeString,
+				Description: "Fully qualified name of the records owned by the record set, all names of the type are owned when not set",
+				Optional:    true,
+				ForceNew:    true,
+				StateFunc: func(i interface{}) string {
+					return strings.ToLower(i.(string))
+				},
+			},
+			cisDNSRecordSetRecords: {
+				Type:        schema.TypeSet,
+				Description: "The records of the record set, records matching the type and name that are not listed are deleted",
+				Optional:    true,
+				Set:         ResourceIBMCISDNSRecordSetRecordHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Description: "Fully qualified DNS record name, in lower case",
+							Required:    true,
+						},
+						cisDNSRecordContent: {
+							Type:        schema.TypeString,
+							Description: "DNS record content",
+							Required:    true,
+						},
+						cisDNSRecordTTL: {
+							Type:             schema.TypeInt,
+							Description:      "TTL value, 1 is automatic. Proxied records always have an automatic TTL",
+							Optional:         true,
+							Default:          1,
+							DiffSuppressFunc: suppressCISDNSRecordSetProxiedTTL,
+						},
+						cisDNSRecordPriority: {
+							Type:        schema.TypeInt,
+							Description: "Priority value of MX records",
+							Optional:    true,
+							Default:     0,
+						},
+						cisDNSRecordProxied: {
+							Type:        schema.TypeBool,
+							Description: "Boolean value true if proxied else false",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			cisDNSRecordSetCount: {
+				Type:        schema.TypeInt,
+				Description: "Number of records in the record set",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ResourceIBMCISDNSRecordSetValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisDNSRecordType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "A, AAAA, CNAME, MX, NS, PTR, SPF, TXT"})
+	ibmCISDNSRecordSetValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_record_set",
+		Schema:       validateSchema}
+	return &ibmCISDNSRecordSetValidator
+}
+
+func resourceIBMCISDNSRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	recordType := d.Get(cisDNSRecordType).(string)
+	name := strings.ToLower(d.Get(cisDNSRecordName).(string))
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", recordType, name, zoneID, crn))
+
+	return resourceIBMCISDNSRecordSetUpdate(d, meta)
+}
+
+func resourceIBMCISDNSRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	recordType, name, zoneID, crn, err := convertCISDNSRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := listCISDNSRecordSetRecords(meta, crn, zoneID, recordType, name)
+	if err != nil {
+		return err
+	}
+
+	records := make([]map[string]interface{}, 0, len(existing))
+	for _, record := range existing {
+		records = append(records, flattenCISDNSRecordSetRecord(record))
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisDNSRecordType, recordType)
+	d.Set(cisDNSRecordName, name)
+	d.Set(cisDNSRecordSetRecords, records)
+	d.Set(cisDNSRecordSetCount, len(records))
+	return nil
+}
+
+func resourceIBMCISDNSRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	recordType, name, zoneID, crn, err := convertCISDNSRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]map[string]interface{}{}
+	for _, r := range d.Get(cisDNSRecordSetRecords).(*schema.Set).List() {
+		record := r.(map[string]interface{})
+		record[cisDNSRecordName] = strings.ToLower(record[cisDNSRecordName].(string))
+		if name != "" && record[cisDNSRecordName].(string) != name {
+			return fmt.Errorf("[ERROR] DNS record %s does not match the record set name %s", record[cisDNSRecordName], name)
+		}
+		wanted[cisDNSRecordSetKey(recordType, record)] = record
+	}
+
+	existing, err := listCISDNSRecordSetRecords(meta, crn, zoneID, recordType, name)
+	if err != nil {
+		return err
+	}
+
+	// Records that are not wanted are updated in place to a new record of the
+	// same name, the remaining new records are imported in a single zone file,
+	// and only then the remaining records that are not wanted are deleted
+	toDelete := []string{}
+	toCreate := []map[string]interface{}{}
+	found := map[string]bool{}
+	for _, record := range existing {
+		key := cisDNSRecordSetKey(recordType, flattenCISDNSRecordSetRecord(record))
+		if _, ok := wanted[key]; !ok || found[key] {
+			toDelete = append(toDelete, *record.ID)
+			continue
+		}
+		found[key] = true
+	}
+	for key, record := range wanted {
+		if !found[key] {
+			toCreate = append(toCreate, record)
+		}
+	}
+	toUpdate, toCreate, toDelete := PairCISDNSRecordSetUpdates(recordType, existing, toCreate, toDelete)
+
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	for recordID, record := range toUpdate {
+		opt := sess.NewUpdateDnsRecordOptions(recordID)
+		opt.SetType(recordType)
+		opt.SetName(record[cisDNSRecordName].(string))
+		opt.SetContent(record[cisDNSRecordContent].(string))
+		opt.SetTTL(int64(record[cisDNSRecordTTL].(int)))
+		opt.SetProxied(record[cisDNSRecordProxied].(bool))
+		if recordType == cisDNSRecordTypeMX {
+			opt.SetPriority(int64(record[cisDNSRecordPriority].(int)))
+		}
+		_, response, err := sess.UpdateDnsRecord(opt)
+		if err != nil {
+			log.Printf("Error updating dns record: %s", response)
+			return err
+		}
+	}
+
+	if len(toCreate) > 0 {
+		bulkSess, err := meta.(conns.ClientSession).CisDNSRecordBulkClientSession()
+		if err != nil {
+			return err
+		}
+		bulkSess.Crn = core.StringPtr(crn)
+		bulkSess.ZoneIdentifier = core.StringPtr(zoneID)
+
+		zoneFile := ""
+		for _, record := range toCreate {
+			zoneFile += cisDNSRecordSetZoneFileLine(recordType, record) + "\n"
+		}
+		opt := bulkSess.NewPostDnsRecordsBulkOptions()
+		opt.SetFile(ioutil.NopCloser(bytes.NewBufferString(zoneFile)))
+		opt.SetFileContentType("text/plain")
+		result, response, err := bulkSess.PostDnsRecordsBulk(opt)
+		if err != nil {
+			log.Printf("Error importing dns records: %v", response)
+			return err
+		}
+		if result.Result != nil && result.Result.RecsAdded != nil && *result.Result.RecsAdded != int64(len(toCreate)) {
+			log.Printf("[WARN] %d of %d dns records were imported", *result.Result.RecsAdded, len(toCreate))
+		}
+	}
+
+	for _, recordID := range toDelete {
+		opt := sess.NewDeleteDnsRecordOptions(recordID)
+		_, response, err := sess.DeleteDnsRecord(opt)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("Error deleting dns record: %s", response)
+			return err
+		}
+	}
+
+	// TTL and proxied can't always be expressed in a zone file, so every record
+	// is reconciled with the individual record API
+	existing, err = listCISDNSRecordSetRecords(meta, crn, zoneID, recordType, name)
+	if err != nil {
+		return err
+	}
+	for _, record := range existing {
+		current := flattenCISDNSRecordSetRecord(record)
+		want, ok := wanted[cisDNSRecordSetKey(recordType, current)]
+		if !ok {
+			continue
+		}
+		sameTTL := current[cisDNSRecordTTL] == want[cisDNSRecordTTL] || want[cisDNSRecordProxied].(bool)
+		if sameTTL && current[cisDNSRecordProxied] == want[cisDNSRecordProxied] {
+			continue
+		}
+		opt := sess.NewUpdateDnsRecordOptions(*record.ID)
+		opt.SetType(recordType)
+		opt.SetName(*record.Name)
+		opt.SetContent(*record.Content)
+		opt.SetTTL(int64(want[cisDNSRecordTTL].(int)))
+		opt.SetProxied(want[cisDNSRecordProxied].(bool))
+		if recordType == cisDNSRecordTypeMX {
+			opt.SetPriority(int64(want[cisDNSRecordPriority].(int)))
+		}
+		_, response, err := sess.UpdateDnsRecord(opt)
+		if err != nil {
+			log.Printf("Error updating dns record: %s", response)
+			return err
+		}
+	}
+
+	return resourceIBMCISDNSRecordSetRead(d, meta)
+}
+
+func resourceIBMCISDNSRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	recordType, name, zoneID, crn, err := convertCISDNSRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := listCISDNSRecordSetRecords(meta, crn, zoneID, recordType, name)
+	if err != nil {
+		return err
+	}
+
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	for _, record := range existing {
+		opt := sess.NewDeleteDnsRecordOptions(*record.ID)
+		_, response, err := sess.DeleteDnsRecord(opt)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("Error deleting dns record: %s", response)
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func convertCISDNSRecordSetID(id string) (recordType, name, zoneID, crn string, err error) {
+	parts := strings.SplitN(id, ":", 4)
+	if len(parts) != 4 {
+		err = fmt.Errorf("[ERROR] Invalid DNS record set ID %s, expected <type>:<name>:<domain_id>:<cis_id>", id)
+		return
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func listCISDNSRecordSetRecords(meta interface{}, crn, zoneID, recordType, name string) ([]dnsrecordsv1.DnsrecordDetails, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	var perPage int64 = 1000
+	records := []dnsrecordsv1.DnsrecordDetails{}
+	for page := int64(1); ; page++ {
+		opt := sess.NewListAllDnsRecordsOptions()
+		opt.SetType(recordType)
+		if name != "" {
+			opt.SetName(name)
+		}
+		opt.SetPage(page)
+		opt.SetPerPage(perPage)
+		result, response, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			log.Printf("Error reading dns records: %s", response)
+			return nil, err
+		}
+		records = append(records, result.Result...)
+		if int64(len(result.Result)) < perPage {
+			break
+		}
+	}
+	return records, nil
+}
+
+func flattenCISDNSRecordSetRecord(record dnsrecordsv1.DnsrecordDetails) map[string]interface{} {
+	r := map[string]interface{}{
+		cisDNSRecordName:     strings.ToLower(*record.Name),
+		cisDNSRecordContent:  "",
+		cisDNSRecordTTL:      1,
+		cisDNSRecordPriority: 0,
+		cisDNSRecordProxied:  false,
+	}
+	if record.Content != nil {
+		r[cisDNSRecordContent] = *record.Content
+	}
+	if record.TTL != nil {
+		r[cisDNSRecordTTL] = int(*record.TTL)
+	}
+	if record.Priority != nil && *record.Type == cisDNSRecordTypeMX {
+		r[cisDNSRecordPriority] = int(*record.Priority)
+	}
+	if record.Proxied != nil {
+		r[cisDNSRecordProxied] = *record.Proxied
+	}
+	return r
+}
+
+// PairCISDNSRecordSetUpdates pairs the records to delete with the records to
+// create of the same name, so that they are updated in place. This keeps a
+// name resolving while its records change, and a CNAME can't be created next
+// to the CNAME it replaces. It returns the updates by record ID, and the
+// records left to create and to delete.
+func PairCISDNSRecordSetUpdates(recordType string, existing []dnsrecordsv1.DnsrecordDetails, toCreate []map[string]interface{}, toDelete []string) (map[string]map[string]interface{}, []map[string]interface{}, []string) {
+	names := make(map[string]string, len(existing))
+	for _, record := range existing {
+		names[*record.ID] = strings.ToLower(*record.Name)
+	}
+	sort.Slice(toCreate, func(i, j int) bool {
+		return cisDNSRecordSetKey(recordType, toCreate[i]) < cisDNSRecordSetKey(recordType, toCreate[j])
+	})
+
+	toUpdate := map[string]map[string]interface{}{}
+	remainingDelete := []string{}
+	for _, recordID := range toDelete {
+		paired := false
+		for i, record := range toCreate {
+			if record[cisDNSRecordName].(string) == names[recordID] {
+				toUpdate[recordID] = record
+				toCreate = append(toCreate[:i], toCreate[i+1:]...)
+				paired = true
+				break
+			}
+		}
+		if !paired {
+			remainingDelete = append(remainingDelete, recordID)
+		}
+	}
+	return toUpdate, toCreate, remainingDelete
+}
+
+// ResourceIBMCISDNSRecordSetRecordHash leaves the TTL of proxied records out,
+// CIS reports an automatic TTL for them whatever TTL is configured.
+func ResourceIBMCISDNSRecordSetRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+	record := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(record[cisDNSRecordName].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", record[cisDNSRecordContent].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", record[cisDNSRecordPriority].(int)))
+	buf.WriteString(fmt.Sprintf("%t-", record[cisDNSRecordProxied].(bool)))
+	if !record[cisDNSRecordProxied].(bool) {
+		buf.WriteString(fmt.Sprintf("%d-", record[cisDNSRecordTTL].(int)))
+	}
+	return conns.String(buf.String())
+}
+
+// suppressCISDNSRecordSetProxiedTTL suppresses the TTL diff of proxied
+// records, which come back with an automatic TTL of 1.
+func suppressCISDNSRecordSetProxiedTTL(k, old, new string, d *schema.ResourceData) bool {
+	proxied, ok := d.GetOk(strings.TrimSuffix(k, cisDNSRecordTTL) + cisDNSRecordProxied)
+	return ok && proxied.(bool)
+}
+
+// cisDNSRecordSetKey identifies a record by the fields that can't be updated
+// in place: the name, the content and for MX records the priority.
+func cisDNSRecordSetKey(recordType string, record map[string]interface{}) string {
+	content := record[cisDNSRecordContent].(string)
+	switch recordType {
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeMX, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		content = strings.ToLower(strings.TrimSuffix(content, "."))
+	}
+	key := fmt.Sprintf("%s|%s", strings.ToLower(record[cisDNSRecordName].(string)), content)
+	if recordType == cisDNSRecordTypeMX {
+		key = fmt.Sprintf("%s|%d", key, record[cisDNSRecordPriority].(int))
+	}
+	return key
+}
+
+// cisDNSRecordSetZoneFileLine renders a record in BIND zone file format.
+func cisDNSRecordSetZoneFileLine(recordType string, record map[string]interface{}) string {
+	name := strings.TrimSuffix(record[cisDNSRecordName].(string), ".") + "."
+	ttl := record[cisDNSRecordTTL].(int)
+	if ttl < 60 {
+		// automatic TTL is set once the record exists
+		ttl = 300
+	}
+	content := record[cisDNSRecordContent].(string)
+	switch recordType {
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		content = strings.TrimSuffix(content, ".") + "."
+	case cisDNSRecordTypeMX:
+		content = fmt.Sprintf("%d %s.", record[cisDNSRecordPriority].(int), strings.TrimSuffix(content, "."))
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		// character strings are limited to 255 characters
+		escaped := strings.ReplaceAll(strings.ReplaceAll(content, `\`, `\\`), `"`, `\"`)
+		chunks := []string{}
+		for len(escaped) > 255 {
+			cut := 255
+			if escaped[cut-1] == '\\' {
+				cut--
+			}
+			chunks = append(chunks, `"`+escaped[:cut]+`"`)
+			escaped = escaped[cut:]
+		}
+		chunks = append(chunks, `"`+escaped+`"`)
+		content = strings.Join(chunks, " ")
+	}
+	return fmt.Sprintf("%s\t%d\tIN\t%s\t%s", name, ttl, recordType, content)
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMCisDNSRecordSet_Basic(t *testing.T) {
+	name := "ibm_cis_dns_record_set.test"
+	recordName := fmt.Sprintf("record-set.%s", acc.CisDomainStatic)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSRecordSetConfigBasic(recordName, []string{"192.168.0.10", "192.168.0.11"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "A"),
+					resource.TestCheckResourceAttr(name, "name", recordName),
+					resource.TestCheckResourceAttr(name, "records.#", "2"),
+					resource.TestCheckResourceAttr(name, "record_count", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDNSRecordSetConfigBasic(recordName, []string{"192.168.0.12"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "record_count", "1"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSRecordSetConfigBasic(recordName string, contents []string) string {
+	records := ""
+	for _, content := range contents {
+		records += fmt.Sprintf(`
+		records {
+			name    = "%s"
+			content = "%s"
+			ttl     = 900
+		}`, recordName, content)
+	}
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_dns_record_set" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		type      = "A"
+		name      = "%s"
+		%s
+	}`, recordName, records)
+}
+
+func TestPairCISDNSRecordSetUpdates(t *testing.T) {
+	existing := []dnsrecordsv1.DnsrecordDetails{
+		{ID: core.StringPtr("id-www"), Name: core.StringPtr("WWW.example.com")},
+		{ID: core.StringPtr("id-api"), Name: core.StringPtr("api.example.com")},
+	}
+	www := map[string]interface{}{"name": "www.example.com", "content": "lb.example.net", "ttl": 1, "priority": 0, "proxied": true}
+	docs := map[string]interface{}{"name": "docs.example.com", "content": "pages.example.net", "ttl": 1, "priority": 0, "proxied": false}
+
+	update, create, remove := cis.PairCISDNSRecordSetUpdates("CNAME", existing, []map[string]interface{}{docs, www}, []string{"id-api", "id-www"})
+	assert.DeepEqual(t, map[string]map[string]interface{}{"id-www": www}, update)
+	assert.DeepEqual(t, []map[string]interface{}{docs}, create)
+	assert.DeepEqual(t, []string{"id-api"}, remove)
+}
+
+func TestResourceIBMCISDNSRecordSetRecordHash(t *testing.T) {
+	testcases := []struct {
+		ttl      int
+		proxied  bool
+		expected bool
+	}{
+		{ttl: 900, proxied: true, expected: true},
+		{ttl: 900, proxied: false, expected: false},
+	}
+
+	for _, c := range testcases {
+		a := map[string]interface{}{"name": "www.example.com", "content": "192.0.2.1", "ttl": c.ttl, "priority": 0, "proxied": c.proxied}
+		b := map[string]interface{}{"name": "www.example.com", "content": "192.0.2.1", "ttl": 1, "priority": 0, "proxied": c.proxied}
+		assert.Equal(t, c.expected, cis.ResourceIBMCISDNSRecordSetRecordHash(a) == cis.ResourceIBMCISDNSRecordSetRecordHash(b))
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	cisdomainsettingsv1 "github.com/IBM/networking-go-sdk/zonessettingsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISDNSSEC                   = "ibm_cis_dnssec"
+	cisDNSSECStatus                = "status"
+	cisDNSSECFlags                 = "flags"
+	cisDNSSECAlgorithm             = "algorithm"
+	cisDNSSECKeyType               = "key_type"
+	cisDNSSECDigestType            = "digest_type"
+	cisDNSSECDigestAlgorithm       = "digest_algorithm"
+	cisDNSSECDigest                = "digest"
+	cisDNSSECDS                    = "ds"
+	cisDNSSECKeyTag                = "key_tag"
+	cisDNSSECPublicKey             = "public_key"
+	cisDNSSECStatusActive          = "active"
+	cisDNSSECStatusDisabled        = "disabled"
+	cisDNSSECStatusPending         = "pending"
+	cisDNSSECStatusPendingDisabled = "pending-disabled"
+)
+
+func ResourceIBMCISDNSSEC() *schema.Resource {
+	return &schema.Resource{
+		Create:   ResourceIBMCISDNSSECUpdate,
+		Read:     ResourceIBMCISDNSSECRead,
+		Update:   ResourceIBMCISDNSSECUpdate,
+		Delete:   ResourceIBMCISDNSSECDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISDNSSEC,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSSECStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          cisDNSSECStatusActive,
+				Description:      "DNSSEC status, active or disabled",
+				DiffSuppressFunc: suppressCISDNSSECStatusDiff,
+				ValidateFunc: validate.InvokeValidator(ibmCISDNSSEC,
+					cisDNSSECStatus),
+			},
+			cisDNSSECFlags: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "DNSKEY flags",
+			},
+			cisDNSSECAlgorithm: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSSEC signing algorithm",
+			},
+			cisDNSSECKeyType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSSEC key type",
+			},
+			cisDNSSECDigestType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record digest type",
+			},
+			cisDNSSECDigestAlgorithm: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record digest algorithm",
+			},
+			cisDNSSECDigest: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record digest",
+			},
+			cisDNSSECDS: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DS record to add at the domain registrar",
+			},
+			cisDNSSECKeyTag: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "DNSSEC key tag",
+			},
+			cisDNSSECPublicKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSSEC public key",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISDNSSECValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisDNSSECStatus,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "active, disabled"})
+	ibmCISDNSSECValidator := validate.ResourceValidator{
+		ResourceName: ibmCISDNSSEC,
+		Schema:       validateSchema}
+	return &ibmCISDNSSECValidator
+}
+
+func ResourceIBMCISDNSSECUpdate(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	if d.IsNewResource() || d.HasChange(cisDNSSECStatus) {
+		opt := cisClient.NewUpdateZoneDnssecOptions()
+		opt.SetStatus(d.Get(cisDNSSECStatus).(string))
+		_, response, err := cisClient.UpdateZoneDnssec(opt)
+		if err != nil {
+			log.Printf("Update zone dnssec failed: %v", response)
+			return err
+		}
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+
+	return ResourceIBMCISDNSSECRead(d, meta)
+}
+
+func ResourceIBMCISDNSSECRead(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	opt := cisClient.NewGetZoneDnssecOptions()
+	result, response, err := cisClient.GetZoneDnssec(opt)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("Zone dnssec %s is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Get zone dnssec failed: %v", response)
+		return err
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	setCISDNSSECResult(d, result.Result)
+	return nil
+}
+
+func ResourceIBMCISDNSSECDelete(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	// DNSSEC cannot be removed from a zone, deleting the resource disables signing.
+	opt := cisClient.NewUpdateZoneDnssecOptions()
+	opt.SetStatus(cisDNSSECStatusDisabled)
+	_, response, err := cisClient.UpdateZoneDnssec(opt)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("Disable zone dnssec failed: %v", response)
+		return err
+	}
+
+	return nil
+}
+
+func setCISDNSSECResult(d *schema.ResourceData, result *cisdomainsettingsv1.ZonesDnssecRespResult) {
+	if result == nil {
+		return
+	}
+	d.Set(cisDNSSECStatus, result.Status)
+	d.Set(cisDNSSECFlags, flex.IntValue(result.Flags))
+	d.Set(cisDNSSECAlgorithm, result.Algorithm)
+	d.Set(cisDNSSECKeyType, result.KeyType)
+	d.Set(cisDNSSECDigestType, result.DigestType)
+	d.Set(cisDNSSECDigestAlgorithm, result.DigestAlgorithm)
+	d.Set(cisDNSSECDigest, result.Digest)
+	d.Set(cisDNSSECDS, result.Ds)
+	d.Set(cisDNSSECKeyTag, flex.IntValue(result.KeyTag))
+	d.Set(cisDNSSECPublicKey, result.PublicKey)
+}
+
+// suppressCISDNSSECStatusDiff treats the transitional states reported while
+// the registrar picks up the DS record as the requested state.
+func suppressCISDNSSECStatusDiff(k, old, new string, d *schema.ResourceData) bool {
+	switch old {
+	case cisDNSSECStatusPending:
+		return new == cisDNSSECStatusActive
+	case cisDNSSECStatusPendingDisabled:
+		return new == cisDNSSECStatusDisabled
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSSEC_Basic(t *testing.T) {
+	name := "ibm_cis_dnssec.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSSECConfigBasic("active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "ds"),
+					resource.TestCheckResourceAttrSet(name, "public_key"),
+					resource.TestCheckResourceAttrSet(name, "key_tag"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDNSSECConfigBasic("disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSSECConfigBasic(status string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_dnssec" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		status    = "%s"
+	}`, status)
+}
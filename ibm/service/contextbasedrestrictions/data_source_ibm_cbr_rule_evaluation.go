@@ -0,0 +1,573 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+const (
+	cbrDecisionAllow         = "allow"
+	cbrDecisionDeny          = "deny"
+	cbrDecisionIndeterminate = "indeterminate"
+
+	cbrRuleApplies    = "applies"
+	cbrRuleMayApply   = "may_apply"
+	cbrRuleNotApplies = "not_applies"
+)
+
+// cbrEvaluationSource is the context a request is sent from.
+type cbrEvaluationSource struct {
+	ipAddress       net.IP
+	vpcCRN          string
+	serviceName     string
+	serviceInstance string
+	serviceLocation string
+	serviceAccount  string
+	endpointType    string
+}
+
+// cbrEvaluationTarget is the resource and operation a request is sent to.
+type cbrEvaluationTarget struct {
+	attributes map[string]string
+	tags       map[string]string
+	apiType    string
+}
+
+func DataSourceIBMCbrRuleEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account whose rules and zones are evaluated. Defaults to the account of the provider.",
+			},
+			"source": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The context the request is sent from.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IP address the request is sent from.",
+						},
+						"vpc_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the VPC the request is sent from.",
+						},
+						"service_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the service the request is sent from.",
+						},
+						"service_instance": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The instance of the service the request is sent from.",
+						},
+						"service_location": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The location of the service the request is sent from.",
+						},
+						"service_account_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The account of the service the request is sent from. Defaults to the evaluated account.",
+						},
+						"endpoint_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+							Description:  "The type of the endpoint the request is sent to, public, private or direct.",
+						},
+					},
+				},
+			},
+			"target": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The resource and operation the request is sent to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the service of the resource.",
+						},
+						"service_instance": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The instance of the service of the resource.",
+						},
+						"region": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The region of the resource.",
+						},
+						"resource_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The type of the resource.",
+						},
+						"resource": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the resource.",
+						},
+						"tags": &schema.Schema{
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The access tags of the resource.",
+						},
+						"api_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the API type of the operation, such as crn:v1:bluemix:public:context-based-restrictions::::api-type:data-plane. When not set, the rules of all API types apply.",
+						},
+					},
+				},
+			},
+			"decision": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the request is allowed or denied by the rules in enabled and report mode, allow, deny or indeterminate when it depends on rules that may apply.",
+			},
+			"enforced_decision": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the request is allowed or denied by the rules in enabled mode only, allow, deny or indeterminate when it depends on rules that may apply.",
+			},
+			"matched_rule_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the rule that allows the request.",
+			},
+			"matched_zone_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the zone that allows the request.",
+			},
+			"rules": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules that apply to the request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule.",
+						},
+						"enforcement_mode": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The enforcement mode of the rule.",
+						},
+						"applicability": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the rule applies to the target, applies or may_apply when the target doesn't set an attribute or tag that the rule restricts.",
+						},
+						"decision": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the rule allows or denies the request, allow or deny.",
+						},
+						"matched_zone_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the zone of the rule that allows the request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrRuleEvaluationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	source, err := DataSourceIBMCbrRuleEvaluationSource(d.Get("source").([]interface{})[0].(map[string]interface{}), accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	target := DataSourceIBMCbrRuleEvaluationTarget(d.Get("target").([]interface{})[0].(map[string]interface{}), accountID)
+
+	listRulesOptions := &contextbasedrestrictionsv1.ListRulesOptions{}
+	listRulesOptions.SetAccountID(accountID)
+	ruleList, response, err := contextBasedRestrictionsClient.ListRulesWithContext(context, listRulesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListRulesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListRulesWithContext failed %s\n%s", err, response))
+	}
+
+	zones := map[string]*contextbasedrestrictionsv1.Zone{}
+	getZone := func(zoneID string) (*contextbasedrestrictionsv1.Zone, error) {
+		if zone, ok := zones[zoneID]; ok {
+			return zone, nil
+		}
+		getZoneOptions := &contextbasedrestrictionsv1.GetZoneOptions{}
+		getZoneOptions.SetZoneID(zoneID)
+		zone, response, err := contextBasedRestrictionsClient.GetZoneWithContext(context, getZoneOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response)
+		}
+		zones[zoneID] = zone
+		return zone, nil
+	}
+
+	var matchedRuleID, matchedZoneID string
+	rules := []map[string]interface{}{}
+	for _, rule := range ruleList.Rules {
+		enforcementMode := contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst
+		if rule.EnforcementMode != nil {
+			enforcementMode = *rule.EnforcementMode
+		}
+		if enforcementMode == contextbasedrestrictionsv1.RuleEnforcementModeDisabledConst {
+			continue
+		}
+		applicability := CbrRuleAppliesToTarget(rule, target)
+		if applicability == cbrRuleNotApplies {
+			continue
+		}
+
+		zoneID, err := CbrRuleAllowedZone(rule, source, getZone)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ruleDecision := cbrDecisionDeny
+		if zoneID != nil {
+			ruleDecision = cbrDecisionAllow
+		}
+		ruleMap := map[string]interface{}{
+			"rule_id":          *rule.ID,
+			"enforcement_mode": enforcementMode,
+			"applicability":    applicability,
+			"decision":         ruleDecision,
+		}
+		if zoneID != nil {
+			ruleMap["matched_zone_id"] = *zoneID
+		}
+		rules = append(rules, ruleMap)
+
+		if zoneID != nil && matchedRuleID == "" && applicability == cbrRuleApplies {
+			matchedRuleID = *rule.ID
+			matchedZoneID = *zoneID
+		}
+	}
+	decision := CbrDecision(rules, "")
+	enforcedDecision := CbrDecision(rules, contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst)
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, target.attributes["serviceName"]))
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("decision", decision); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting decision: %s", err))
+	}
+	if err = d.Set("enforced_decision", enforcedDecision); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enforced_decision: %s", err))
+	}
+	if err = d.Set("matched_rule_id", matchedRuleID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting matched_rule_id: %s", err))
+	}
+	if err = d.Set("matched_zone_id", matchedZoneID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting matched_zone_id: %s", err))
+	}
+	if err = d.Set("rules", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rules: %s", err))
+	}
+
+	return nil
+}
+
+func DataSourceIBMCbrRuleEvaluationSource(modelMap map[string]interface{}, accountID string) (*cbrEvaluationSource, error) {
+	source := &cbrEvaluationSource{
+		vpcCRN:          modelMap["vpc_crn"].(string),
+		serviceName:     modelMap["service_name"].(string),
+		serviceInstance: modelMap["service_instance"].(string),
+		serviceLocation: modelMap["service_location"].(string),
+		serviceAccount:  modelMap["service_account_id"].(string),
+		endpointType:    modelMap["endpoint_type"].(string),
+	}
+	if ipAddress := modelMap["ip_address"].(string); ipAddress != "" {
+		source.ipAddress = net.ParseIP(ipAddress)
+		if source.ipAddress == nil {
+			return nil, fmt.Errorf("[ERROR] Invalid source IP address %s", ipAddress)
+		}
+	}
+	if source.serviceAccount == "" {
+		source.serviceAccount = accountID
+	}
+	return source, nil
+}
+
+func DataSourceIBMCbrRuleEvaluationTarget(modelMap map[string]interface{}, accountID string) *cbrEvaluationTarget {
+	target := &cbrEvaluationTarget{
+		attributes: map[string]string{
+			"accountId":   accountID,
+			"serviceName": modelMap["service_name"].(string),
+		},
+		tags:    map[string]string{},
+		apiType: modelMap["api_type"].(string),
+	}
+	for key, name := range map[string]string{
+		"service_instance": "serviceInstance",
+		"region":           "region",
+		"resource_type":    "resourceType",
+		"resource":         "resource",
+	} {
+		if value := modelMap[key].(string); value != "" {
+			target.attributes[name] = value
+		}
+	}
+	for name, value := range modelMap["tags"].(map[string]interface{}) {
+		target.tags[name] = value.(string)
+	}
+	return target
+}
+
+// CbrRuleAppliesToTarget reports whether one of the resources and the
+// operations of the rule match the target. A resource that restricts an
+// attribute or tag that the target doesn't set may match the target, so the
+// rule may apply rather than not apply.
+func CbrRuleAppliesToTarget(rule contextbasedrestrictionsv1.Rule, target *cbrEvaluationTarget) string {
+	if rule.Operations != nil && len(rule.Operations.APITypes) > 0 && target.apiType != "" {
+		found := false
+		for _, apiType := range rule.Operations.APITypes {
+			if apiType.APITypeID != nil && *apiType.APITypeID == target.apiType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return cbrRuleNotApplies
+		}
+	}
+
+	applicability := cbrRuleNotApplies
+	for _, resource := range rule.Resources {
+		matches := cbrRuleApplies
+		for _, attribute := range resource.Attributes {
+			value, ok := target.attributes[*attribute.Name]
+			if !ok {
+				matches = cbrRuleMayApply
+			} else if !CbrAttributeMatches(attribute.Operator, *attribute.Value, value) {
+				matches = cbrRuleNotApplies
+				break
+			}
+		}
+		if matches != cbrRuleNotApplies {
+			for _, tag := range resource.Tags {
+				value, ok := target.tags[*tag.Name]
+				if !ok {
+					matches = cbrRuleMayApply
+				} else if !CbrAttributeMatches(tag.Operator, *tag.Value, value) {
+					matches = cbrRuleNotApplies
+					break
+				}
+			}
+		}
+		if matches == cbrRuleApplies {
+			return cbrRuleApplies
+		}
+		if matches == cbrRuleMayApply {
+			applicability = cbrRuleMayApply
+		}
+	}
+	return applicability
+}
+
+// CbrRuleAllowedZone returns the ID of the first zone of a context of the rule
+// that allows the source, or nil when no context allows it.
+func CbrRuleAllowedZone(rule contextbasedrestrictionsv1.Rule, source *cbrEvaluationSource, getZone func(string) (*contextbasedrestrictionsv1.Zone, error)) (*string, error) {
+	for _, ruleContext := range rule.Contexts {
+		var zoneIDs []string
+		endpointMatches := true
+		for _, attribute := range ruleContext.Attributes {
+			switch *attribute.Name {
+			case "networkZoneId":
+				zoneIDs = strings.Split(*attribute.Value, ",")
+			case "endpointType":
+				endpointMatches = false
+				for _, endpointType := range strings.Split(*attribute.Value, ",") {
+					if strings.TrimSpace(endpointType) == source.endpointType {
+						endpointMatches = true
+					}
+				}
+			}
+		}
+		if !endpointMatches {
+			continue
+		}
+		if zoneIDs == nil {
+			empty := ""
+			return &empty, nil
+		}
+		for _, zoneID := range zoneIDs {
+			zoneID = strings.TrimSpace(zoneID)
+			zone, err := getZone(zoneID)
+			if err != nil {
+				return nil, err
+			}
+			if CbrZoneContainsSource(zone, source) {
+				return &zoneID, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func CbrZoneContainsSource(zone *contextbasedrestrictionsv1.Zone, source *cbrEvaluationSource) bool {
+	for _, excluded := range zone.Excluded {
+		if cbrAddressMatches(excluded, source) {
+			return false
+		}
+	}
+	for _, address := range zone.Addresses {
+		if cbrAddressMatches(address, source) {
+			return true
+		}
+	}
+	return false
+}
+
+func cbrAddressMatches(address contextbasedrestrictionsv1.AddressIntf, source *cbrEvaluationSource) bool {
+	switch address := address.(type) {
+	case *contextbasedrestrictionsv1.AddressIPAddress:
+		return source.ipAddress != nil && source.ipAddress.Equal(net.ParseIP(*address.Value))
+	case *contextbasedrestrictionsv1.AddressIPAddressRange:
+		bounds := strings.Split(*address.Value, "-")
+		if source.ipAddress == nil || len(bounds) != 2 {
+			return false
+		}
+		return CbrIPInRange(source.ipAddress, net.ParseIP(bounds[0]), net.ParseIP(bounds[1]))
+	case *contextbasedrestrictionsv1.AddressSubnet:
+		_, subnet, err := net.ParseCIDR(*address.Value)
+		return err == nil && source.ipAddress != nil && subnet.Contains(source.ipAddress)
+	case *contextbasedrestrictionsv1.AddressVPC:
+		return source.vpcCRN != "" && source.vpcCRN == *address.Value
+	case *contextbasedrestrictionsv1.AddressServiceRef:
+		ref := address.Ref
+		if ref == nil || source.serviceName == "" {
+			return false
+		}
+		return cbrOptionalEquals(ref.ServiceName, source.serviceName) &&
+			cbrOptionalEquals(ref.ServiceInstance, source.serviceInstance) &&
+			cbrOptionalEquals(ref.Location, source.serviceLocation) &&
+			cbrOptionalEquals(ref.AccountID, source.serviceAccount)
+	}
+	return false
+}
+
+func CbrIPInRange(ip, first, last net.IP) bool {
+	if first == nil || last == nil {
+		return false
+	}
+	ip, first, last = ip.To16(), first.To16(), last.To16()
+	return compareIPs(ip, first) >= 0 && compareIPs(ip, last) <= 0
+}
+
+func compareIPs(a, b net.IP) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// cbrOptionalEquals reports whether the reference value is unset or equal to
+// the source value.
+func cbrOptionalEquals(refValue *string, value string) bool {
+	return refValue == nil || *refValue == "" || *refValue == value
+}
+
+// CbrAttributeMatches compares a resource attribute with the stringEquals or
+// the stringMatch operator, where * matches any characters and ? one.
+func CbrAttributeMatches(operator *string, pattern, value string) bool {
+	if operator == nil || *operator != "stringMatch" {
+		return pattern == value
+	}
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.ReplaceAll(expression, `\*`, ".*")
+	expression = strings.ReplaceAll(expression, `\?`, ".")
+	matched, err := regexp.MatchString("^"+expression+"$", value)
+	return err == nil && matched
+}
+
+// CbrDecision combines the decisions of the rules in the enforcement mode, or
+// of all the rules when it is empty. A request is allowed when no rule applies
+// to it, or when at least one of the rules that apply to it allows it. When the
+// decision depends on whether the rules that may apply do apply, it is
+// indeterminate.
+func CbrDecision(rules []map[string]interface{}, enforcementMode string) string {
+	applyingRules, applyingAllow := 0, false
+	mayAllow, mayDeny := false, false
+	for _, rule := range rules {
+		if enforcementMode != "" && rule["enforcement_mode"] != enforcementMode {
+			continue
+		}
+		allows := rule["decision"] == cbrDecisionAllow
+		if rule["applicability"] == cbrRuleMayApply {
+			mayAllow = mayAllow || allows
+			mayDeny = mayDeny || !allows
+			continue
+		}
+		applyingRules++
+		applyingAllow = applyingAllow || allows
+	}
+
+	switch {
+	case applyingAllow:
+		return cbrDecisionAllow
+	case applyingRules > 0 && mayAllow:
+		return cbrDecisionIndeterminate
+	case applyingRules > 0:
+		return cbrDecisionDeny
+	case mayDeny:
+		return cbrDecisionIndeterminate
+	}
+	return cbrDecisionAllow
+}
@@ -0,0 +1,364 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gotest.tools/assert"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/contextbasedrestrictions"
+)
+
+func TestAccIBMCbrRuleEvaluationDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleEvaluationDataSourceConfig("169.23.22.20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "enforced_decision", "allow"),
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "matched_rule_id", "ibm_cbr_rule.cbr_rule", "id"),
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "matched_zone_id", "ibm_cbr_zone.cbr_zone", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleEvaluationDataSourceConfig("169.23.22.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "decision", "deny"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "enforced_decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "matched_rule_id", ""),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "rules.0.enforcement_mode", "report"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleEvaluationDataSourceConfig(ipAddress string) string {
+	return fmt.Sprintf(`
+		data "ibm_iam_account_settings" "iam_account_settings" {
+		}
+
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Zone Rule Evaluation"
+			description = "Test Zone Rule Evaluation"
+			account_id = data.ibm_iam_account_settings.iam_account_settings.account_id
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+			excluded {
+				type = "ipAddress"
+				value = "169.23.22.10"
+			}
+		}
+
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description = "Test Rule Evaluation"
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = ibm_cbr_zone.cbr_zone.id
+				}
+			}
+			resources {
+				attributes {
+					name = "accountId"
+					value = data.ibm_iam_account_settings.iam_account_settings.account_id
+				}
+				attributes {
+					name = "serviceName"
+					value = "iam-groups"
+				}
+			}
+			enforcement_mode = "report"
+		}
+
+		data "ibm_cbr_rule_evaluation" "cbr_rule_evaluation" {
+			account_id = ibm_cbr_rule.cbr_rule.resources[0].attributes[0].value
+			source {
+				ip_address = "%s"
+			}
+			target {
+				service_name = "iam-groups"
+			}
+		}
+	`, ipAddress)
+}
+
+// testCbrEvaluationSource returns the source block of the data source with
+// the given arguments set and the others empty.
+func testCbrEvaluationSource(arguments map[string]interface{}) map[string]interface{} {
+	source := map[string]interface{}{
+		"ip_address":         "",
+		"vpc_crn":            "",
+		"service_name":       "",
+		"service_instance":   "",
+		"service_location":   "",
+		"service_account_id": "",
+		"endpoint_type":      "",
+	}
+	for key, value := range arguments {
+		source[key] = value
+	}
+	return source
+}
+
+// testCbrEvaluationTarget returns the target block of the data source with
+// the given arguments set and the others empty.
+func testCbrEvaluationTarget(arguments map[string]interface{}) map[string]interface{} {
+	target := map[string]interface{}{
+		"service_name":     "cloud-object-storage",
+		"service_instance": "",
+		"region":           "",
+		"resource_type":    "",
+		"resource":         "",
+		"api_type":         "",
+		"tags":             map[string]interface{}{},
+	}
+	for key, value := range arguments {
+		target[key] = value
+	}
+	return target
+}
+
+func TestCbrAttributeMatches(t *testing.T) {
+	testcases := []struct {
+		operator *string
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{nil, "us-south", "us-south", true},
+		{nil, "us-south", "us-east", false},
+		{core.StringPtr("stringEquals"), "us-*", "us-south", false},
+		{core.StringPtr("stringMatch"), "us-*", "us-south", true},
+		{core.StringPtr("stringMatch"), "us-*", "eu-de", false},
+		{core.StringPtr("stringMatch"), "bucket-?", "bucket-1", true},
+		{core.StringPtr("stringMatch"), "bucket-?", "bucket-12", false},
+		{core.StringPtr("stringMatch"), "a.b", "aXb", false},
+	}
+
+	for _, c := range testcases {
+		assert.Equal(t, c.expected, contextbasedrestrictions.CbrAttributeMatches(c.operator, c.pattern, c.value))
+	}
+}
+
+func TestCbrIPInRange(t *testing.T) {
+	testcases := []struct {
+		ip, first, last string
+		expected        bool
+	}{
+		{"10.0.0.5", "10.0.0.1", "10.0.0.10", true},
+		{"10.0.0.1", "10.0.0.1", "10.0.0.10", true},
+		{"10.0.0.10", "10.0.0.1", "10.0.0.10", true},
+		{"10.0.0.11", "10.0.0.1", "10.0.0.10", false},
+		{"10.0.1.0", "10.0.0.1", "10.0.0.255", false},
+		{"9.255.255.255", "10.0.0.1", "10.0.0.10", false},
+		{"2001:db8::5", "2001:db8::1", "2001:db8::ff", true},
+		{"2001:db8::1:0", "2001:db8::1", "2001:db8::ff", false},
+		{"10.0.0.5", "invalid", "10.0.0.10", false},
+	}
+
+	for _, c := range testcases {
+		assert.Equal(t, c.expected, contextbasedrestrictions.CbrIPInRange(net.ParseIP(c.ip), net.ParseIP(c.first), net.ParseIP(c.last)))
+	}
+}
+
+func TestCbrZoneContainsSource(t *testing.T) {
+	zone := &contextbasedrestrictionsv1.Zone{
+		Addresses: []contextbasedrestrictionsv1.AddressIntf{
+			&contextbasedrestrictionsv1.AddressIPAddress{Value: core.StringPtr("169.23.22.20")},
+			&contextbasedrestrictionsv1.AddressIPAddressRange{Value: core.StringPtr("169.23.56.0-169.23.56.255")},
+			&contextbasedrestrictionsv1.AddressSubnet{Value: core.StringPtr("10.1.0.0/16")},
+			&contextbasedrestrictionsv1.AddressVPC{Value: core.StringPtr("crn:v1:bluemix:public:is:us-south:a/acct::vpc:r006-1")},
+			&contextbasedrestrictionsv1.AddressServiceRef{Ref: &contextbasedrestrictionsv1.ServiceRefValue{
+				ServiceName: core.StringPtr("containers-kubernetes"),
+				AccountID:   core.StringPtr("acct"),
+			}},
+		},
+		Excluded: []contextbasedrestrictionsv1.AddressIntf{
+			&contextbasedrestrictionsv1.AddressIPAddress{Value: core.StringPtr("10.1.2.3")},
+		},
+	}
+
+	testcases := []struct {
+		source   map[string]interface{}
+		expected bool
+	}{
+		{map[string]interface{}{"ip_address": "169.23.22.20"}, true},
+		{map[string]interface{}{"ip_address": "169.23.56.100"}, true},
+		{map[string]interface{}{"ip_address": "10.1.200.1"}, true},
+		{map[string]interface{}{"ip_address": "10.1.2.3"}, false},
+		{map[string]interface{}{"ip_address": "8.8.8.8"}, false},
+		{map[string]interface{}{"vpc_crn": "crn:v1:bluemix:public:is:us-south:a/acct::vpc:r006-1"}, true},
+		{map[string]interface{}{"service_name": "containers-kubernetes"}, true},
+		{map[string]interface{}{"service_name": "containers-kubernetes", "service_account_id": "other"}, false},
+		{map[string]interface{}{}, false},
+	}
+
+	for _, c := range testcases {
+		source, err := contextbasedrestrictions.DataSourceIBMCbrRuleEvaluationSource(testCbrEvaluationSource(c.source), "acct")
+		assert.NilError(t, err)
+		assert.Equal(t, c.expected, contextbasedrestrictions.CbrZoneContainsSource(zone, source))
+	}
+}
+
+func TestCbrRuleAllowedZone(t *testing.T) {
+	zones := map[string]*contextbasedrestrictionsv1.Zone{
+		"office": {
+			Addresses: []contextbasedrestrictionsv1.AddressIntf{
+				&contextbasedrestrictionsv1.AddressSubnet{Value: core.StringPtr("169.23.0.0/16")},
+			},
+		},
+		"vpn": {
+			Addresses: []contextbasedrestrictionsv1.AddressIntf{
+				&contextbasedrestrictionsv1.AddressIPAddress{Value: core.StringPtr("10.0.0.1")},
+			},
+		},
+	}
+	getZone := func(zoneID string) (*contextbasedrestrictionsv1.Zone, error) {
+		if zone, ok := zones[zoneID]; ok {
+			return zone, nil
+		}
+		return nil, fmt.Errorf("zone %s not found", zoneID)
+	}
+
+	testcases := []struct {
+		attributes map[string]string
+		source     map[string]interface{}
+		expected   *string
+		err        bool
+	}{
+		{
+			attributes: map[string]string{"networkZoneId": "office, vpn"},
+			source:     map[string]interface{}{"ip_address": "10.0.0.1", "endpoint_type": "public"},
+			expected:   core.StringPtr("vpn"),
+		},
+		{
+			attributes: map[string]string{"networkZoneId": "office", "endpointType": "private"},
+			source:     map[string]interface{}{"ip_address": "169.23.1.1", "endpoint_type": "public"},
+		},
+		{
+			attributes: map[string]string{"endpointType": "private,direct"},
+			source:     map[string]interface{}{"endpoint_type": "direct"},
+			expected:   core.StringPtr(""),
+		},
+		{
+			attributes: map[string]string{"networkZoneId": "office"},
+			source:     map[string]interface{}{"ip_address": "8.8.8.8", "endpoint_type": "public"},
+		},
+		{
+			attributes: map[string]string{"networkZoneId": "deleted"},
+			source:     map[string]interface{}{"ip_address": "8.8.8.8", "endpoint_type": "public"},
+			err:        true,
+		},
+	}
+
+	for _, c := range testcases {
+		ruleContext := contextbasedrestrictionsv1.RuleContext{}
+		for name, value := range c.attributes {
+			ruleContext.Attributes = append(ruleContext.Attributes, contextbasedrestrictionsv1.RuleContextAttribute{
+				Name:  core.StringPtr(name),
+				Value: core.StringPtr(value),
+			})
+		}
+		rule := contextbasedrestrictionsv1.Rule{Contexts: []contextbasedrestrictionsv1.RuleContext{ruleContext}}
+		source, err := contextbasedrestrictions.DataSourceIBMCbrRuleEvaluationSource(testCbrEvaluationSource(c.source), "acct")
+		assert.NilError(t, err)
+
+		actual, err := contextbasedrestrictions.CbrRuleAllowedZone(rule, source, getZone)
+		if c.err {
+			assert.Assert(t, err != nil)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.DeepEqual(t, c.expected, actual)
+	}
+}
+
+func TestCbrRuleAppliesToTarget(t *testing.T) {
+	rule := contextbasedrestrictionsv1.Rule{
+		Resources: []contextbasedrestrictionsv1.Resource{
+			{
+				Attributes: []contextbasedrestrictionsv1.ResourceAttribute{
+					{Name: core.StringPtr("serviceName"), Value: core.StringPtr("cloud-object-storage")},
+					{Name: core.StringPtr("region"), Value: core.StringPtr("us-*"), Operator: core.StringPtr("stringMatch")},
+				},
+				Tags: []contextbasedrestrictionsv1.ResourceTagAttribute{
+					{Name: core.StringPtr("env"), Value: core.StringPtr("prod")},
+				},
+			},
+		},
+		Operations: &contextbasedrestrictionsv1.NewRuleOperations{
+			APITypes: []contextbasedrestrictionsv1.NewRuleOperationsAPITypesItem{
+				{APITypeID: core.StringPtr("crn:v1:bluemix:public:context-based-restrictions::::api-type:data-plane")},
+			},
+		},
+	}
+	prod := map[string]interface{}{"env": "prod"}
+
+	testcases := []struct {
+		target   map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"region": "us-south", "tags": prod}, "applies"},
+		{map[string]interface{}{"region": "eu-de", "tags": prod}, "not_applies"},
+		{map[string]interface{}{"tags": prod}, "may_apply"},
+		{map[string]interface{}{"region": "us-south"}, "may_apply"},
+		{map[string]interface{}{"region": "us-south", "tags": map[string]interface{}{"env": "dev"}}, "not_applies"},
+		{map[string]interface{}{"tags": map[string]interface{}{"env": "dev"}}, "not_applies"},
+		{map[string]interface{}{"region": "us-south", "tags": prod, "api_type": "crn:v1:bluemix:public:context-based-restrictions::::api-type:data-plane"}, "applies"},
+		{map[string]interface{}{"region": "us-south", "tags": prod, "api_type": "crn:v1:bluemix:public:context-based-restrictions::::api-type:management"}, "not_applies"},
+	}
+
+	for _, c := range testcases {
+		target := contextbasedrestrictions.DataSourceIBMCbrRuleEvaluationTarget(testCbrEvaluationTarget(c.target), "acct")
+		assert.Equal(t, c.expected, contextbasedrestrictions.CbrRuleAppliesToTarget(rule, target))
+	}
+}
+
+func TestCbrDecision(t *testing.T) {
+	enabled := contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst
+	report := contextbasedrestrictionsv1.RuleEnforcementModeReportConst
+
+	testcases := []struct {
+		rules           [][]string
+		enforcementMode string
+		expected        string
+	}{
+		{nil, "", "allow"},
+		{[][]string{{"applies", "deny", enabled}, {"applies", "allow", enabled}}, "", "allow"},
+		{[][]string{{"applies", "deny", enabled}}, "", "deny"},
+		{[][]string{{"applies", "deny", report}}, enabled, "allow"},
+		{[][]string{{"applies", "deny", report}}, "", "deny"},
+		{[][]string{{"may_apply", "deny", enabled}}, "", "indeterminate"},
+		{[][]string{{"may_apply", "allow", enabled}}, "", "allow"},
+		{[][]string{{"applies", "deny", enabled}, {"may_apply", "allow", enabled}}, "", "indeterminate"},
+		{[][]string{{"applies", "allow", enabled}, {"may_apply", "deny", enabled}}, "", "allow"},
+	}
+
+	for _, c := range testcases {
+		rules := []map[string]interface{}{}
+		for _, rule := range c.rules {
+			rules = append(rules, map[string]interface{}{
+				"applicability":    rule[0],
+				"decision":         rule[1],
+				"enforcement_mode": rule[2],
+			})
+		}
+		assert.Equal(t, c.expected, contextbasedrestrictions.CbrDecision(rules, c.enforcementMode))
+	}
+}
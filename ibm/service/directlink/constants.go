@@ -83,6 +83,19 @@ const (
 	dlRouteReportComplete          = "complete"
 	dlRouteReportId                = "route_report_id"
 	dlResourceId                   = "id"
+	dlAction                       = "action"
+	dlBefore                       = "before"
+	dlGe                           = "ge"
+	dlLe                           = "le"
+	dlRouteFilterId                = "route_filter_id"
+	dlRouteFilters                 = "route_filters"
+	dlDefaultRouteFilter           = "default_route_filter"
+	dlDefaultImportRouteFilter     = "default_import_route_filter"
+	dlDefaultExportRouteFilter     = "default_export_route_filter"
+	dlImportRouteFilters           = "import_route_filters"
+	dlExportRouteFilters           = "export_route_filters"
+	dlChangeRequestPending         = "change_pending"
+	dlChangeRequestDone            = "done"
 )
 
 func NewInt64Pointer(v int64) *int64 {
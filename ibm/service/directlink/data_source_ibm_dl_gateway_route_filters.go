@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMDLGatewayImportRouteFilters() *schema.Resource {
+	return dataSourceIBMDLGatewayRouteFilters(dlImportRouteFilters)
+}
+
+func DataSourceIBMDLGatewayExportRouteFilters() *schema.Resource {
+	return dataSourceIBMDLGatewayRouteFilters(dlExportRouteFilters)
+}
+
+// dataSourceIBMDLGatewayRouteFilters lists the import or export route filters
+// of a gateway in the order they are evaluated.
+func dataSourceIBMDLGatewayRouteFilters(filterType string) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return dataSourceIBMDLGatewayRouteFiltersRead(d, meta, filterType)
+		},
+		Schema: map[string]*schema.Schema{
+			dlGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Direct Link gateway identifier",
+			},
+			dlDefaultRouteFilter: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The action applied to the routes that match none of the route filters",
+			},
+			dlRouteFilters: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of route filters of the gateway, in the order they are evaluated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dlId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the route filter",
+						},
+						dlAction: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the routes that match the route filter are permitted or denied",
+						},
+						dlPrefix: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP prefix the route filter matches",
+						},
+						dlBefore: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the route filter evaluated after this route filter",
+						},
+						dlGe: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The minimum prefix length of the routes matched",
+						},
+						dlLe: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum prefix length of the routes matched",
+						},
+						dlCreatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the route filter was created",
+						},
+						dlUpdatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the route filter was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMDLGatewayRouteFiltersRead(d *schema.ResourceData, meta interface{}, filterType string) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	gatewayId := d.Get(dlGatewayId).(string)
+	collection := map[string][]dlRouteFilter{}
+	response, err := directLinkRouteFilterRequest(directLink, core.GET, gatewayId, filterType, "", nil, &collection)
+	if err != nil {
+		log.Println("[WARN] Error listing Direct Link Gateway", filterType, response, err)
+		return fmt.Errorf("[ERROR] Error listing Direct Link Gateway(%s) %s err %s\n%s", gatewayId, filterType, err, response)
+	}
+
+	routeFilters := make([]map[string]interface{}, 0)
+	for _, routeFilter := range collection[filterType] {
+		routeFilterItem := map[string]interface{}{}
+		routeFilterItem[dlId] = routeFilter.ID
+		routeFilterItem[dlAction] = routeFilter.Action
+		routeFilterItem[dlPrefix] = routeFilter.Prefix
+		routeFilterItem[dlBefore] = routeFilter.Before
+		if routeFilter.Ge != nil {
+			routeFilterItem[dlGe] = *routeFilter.Ge
+		}
+		if routeFilter.Le != nil {
+			routeFilterItem[dlLe] = *routeFilter.Le
+		}
+		routeFilterItem[dlCreatedAt] = routeFilter.CreatedAt
+		routeFilterItem[dlUpdatedAt] = routeFilter.UpdatedAt
+		routeFilters = append(routeFilters, routeFilterItem)
+	}
+	d.Set(dlRouteFilters, routeFilters)
+
+	defaults, response, err := getDirectLinkGatewayRouteFilterDefaults(directLink, gatewayId)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway(%s) default route filters: %s\n%s", gatewayId, err, response)
+	}
+	if filterType == dlImportRouteFilters {
+		d.Set(dlDefaultRouteFilter, defaults.DefaultImportRouteFilter)
+	} else {
+		d.Set(dlDefaultRouteFilter, defaults.DefaultExportRouteFilter)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, filterType))
+	return nil
+}
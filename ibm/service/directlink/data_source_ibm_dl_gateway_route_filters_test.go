@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDLGatewayRouteFiltersDataSource_basic(t *testing.T) {
+	importNode := "data.ibm_dl_gateway_import_route_filters.test_dl_import_route_filters"
+	exportNode := "data.ibm_dl_gateway_export_route_filters.test_dl_export_route_filters"
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayRouteFiltersDataSourceConfig(gatewayname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(importNode, "route_filters.#", "1"),
+					resource.TestCheckResourceAttr(importNode, "route_filters.0.prefix", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr(importNode, "default_route_filter", "deny"),
+					resource.TestCheckResourceAttr(exportNode, "route_filters.#", "0"),
+					resource.TestCheckResourceAttr(exportNode, "default_route_filter", "permit"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLGatewayRouteFiltersDataSourceConfig(gatewayname string) string {
+	return fmt.Sprintf(`
+	data "ibm_dl_ports" "ds_dlports" {
+	}
+
+	resource ibm_dl_gateway test_dl_gateway {
+		bgp_asn =  64999
+		global = true
+		metered = false
+		name = "%s"
+		speed_mbps = 1000
+		type =  "connect"
+		port = data.ibm_dl_ports.ds_dlports.ports[0].port_id
+		default_import_route_filter = "deny"
+	}
+
+	resource ibm_dl_gateway_import_route_filter dl_import_route_filter {
+		gateway = ibm_dl_gateway.test_dl_gateway.id
+		action  = "permit"
+		prefix  = "10.10.0.0/16"
+	}
+
+	data "ibm_dl_gateway_import_route_filters" "test_dl_import_route_filters" {
+		gateway = ibm_dl_gateway_import_route_filter.dl_import_route_filter.gateway
+	}
+
+	data "ibm_dl_gateway_export_route_filters" "test_dl_export_route_filters" {
+		gateway = ibm_dl_gateway.test_dl_gateway.id
+	}
+	`, gatewayname)
+}
@@ -357,6 +357,20 @@ func ResourceIBMDLGateway() *schema.Resource {
 				Computed:    true,
 				Description: "Changes pending approval for provider managed Direct Link Connect gateways",
 			},
+			dlDefaultImportRouteFilter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_gateway", dlDefaultImportRouteFilter),
+				Description:  "The action applied to the on-prem routes that match none of the import route filters, permit or deny",
+			},
+			dlDefaultExportRouteFilter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_gateway", dlDefaultExportRouteFilter),
+				Description:  "The action applied to the IBM routes that match none of the export route filters, permit or deny",
+			},
 			dlCompletionNoticeRejectReason: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -429,6 +443,7 @@ func ResourceIBMDLGatewayValidator() *validate.ResourceValidator {
 	dlTypeAllowedValues := "dedicated, connect"
 	dlConnectionModeAllowedValues := "direct, transit"
 	dlPolicyAllowedValues := "export, import"
+	dlRouteFilterActionAllowedValues := "permit, deny"
 
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -494,6 +509,20 @@ func ResourceIBMDLGatewayValidator() *validate.ResourceValidator {
 			Required:                   true,
 			MinValue:                   "3",
 			MaxValue:                   "10"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlDefaultImportRouteFilter,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              dlRouteFilterActionAllowedValues})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlDefaultExportRouteFilter,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              dlRouteFilterActionAllowedValues})
 
 	ibmISDLGatewayResourceValidator := validate.ResourceValidator{ResourceName: "ibm_dl_gateway", Schema: validateSchema}
 	return &ibmISDLGatewayResourceValidator
@@ -741,6 +770,20 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
+	routeFilterDefaults := &dlGatewayRouteFilterDefaults{}
+	if defaultImport, ok := d.GetOk(dlDefaultImportRouteFilter); ok {
+		routeFilterDefaults.DefaultImportRouteFilter = NewStrPointer(defaultImport.(string))
+	}
+	if defaultExport, ok := d.GetOk(dlDefaultExportRouteFilter); ok {
+		routeFilterDefaults.DefaultExportRouteFilter = NewStrPointer(defaultExport.(string))
+	}
+	if routeFilterDefaults.DefaultImportRouteFilter != nil || routeFilterDefaults.DefaultExportRouteFilter != nil {
+		err = setDirectLinkGatewayRouteFilterDefaults(directLink, d.Id(), routeFilterDefaults, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(dlTags); ok || v != "" {
 		oldList, newList := d.GetChange(dlTags)
@@ -924,6 +967,18 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		gatewayChangeRequest := gatewayChangeRequestIntf.(*directlinkv1.GatewayChangeRequest)
 		d.Set(dlChangeRequest, *gatewayChangeRequest.Type)
 	}
+	// the defaults are only read for gateways that manage them, to spare every other
+	// gateway an extra request on each refresh
+	_, importOk := d.GetOk(dlDefaultImportRouteFilter)
+	_, exportOk := d.GetOk(dlDefaultExportRouteFilter)
+	if importOk || exportOk {
+		routeFilterDefaults, response, err := getDirectLinkGatewayRouteFilterDefaults(directLink, ID)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway default route filters (%s Template): %s\n%s", dtype, err, response)
+		}
+		d.Set(dlDefaultImportRouteFilter, routeFilterDefaults.DefaultImportRouteFilter)
+		d.Set(dlDefaultExportRouteFilter, routeFilterDefaults.DefaultExportRouteFilter)
+	}
 	tags, err := flex.GetTagsUsingCRN(meta, *instance.Crn)
 	if err != nil {
 		log.Printf(
@@ -1190,6 +1245,21 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if d.HasChanges(dlDefaultImportRouteFilter, dlDefaultExportRouteFilter) {
+		routeFilterDefaults := &dlGatewayRouteFilterDefaults{}
+		if defaultImport, ok := d.GetOk(dlDefaultImportRouteFilter); ok && d.HasChange(dlDefaultImportRouteFilter) {
+			routeFilterDefaults.DefaultImportRouteFilter = NewStrPointer(defaultImport.(string))
+		}
+		if defaultExport, ok := d.GetOk(dlDefaultExportRouteFilter); ok && d.HasChange(dlDefaultExportRouteFilter) {
+			routeFilterDefaults.DefaultExportRouteFilter = NewStrPointer(defaultExport.(string))
+		}
+		err = setDirectLinkGatewayRouteFilterDefaults(directLink, ID, routeFilterDefaults, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			log.Printf("[DEBUG] Update Direct Link Gateway default route filters err %s", err)
+			return err
+		}
+	}
+
 	return resourceIBMdlGatewayRead(d, meta)
 }
 
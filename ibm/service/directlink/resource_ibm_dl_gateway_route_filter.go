@@ -0,0 +1,469 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dlRouteFilter is a gateway import or export route filter. The route filter
+// APIs are not exposed by the directlinkv1 SDK client.
+type dlRouteFilter struct {
+	ID        *string `json:"id,omitempty"`
+	Action    *string `json:"action,omitempty"`
+	Before    *string `json:"before,omitempty"`
+	Ge        *int64  `json:"ge,omitempty"`
+	Le        *int64  `json:"le,omitempty"`
+	Prefix    *string `json:"prefix,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// dlGatewayRouteFilterDefaults holds the actions applied to the routes that
+// match none of the gateway route filters.
+type dlGatewayRouteFilterDefaults struct {
+	DefaultExportRouteFilter *string `json:"default_export_route_filter,omitempty"`
+	DefaultImportRouteFilter *string `json:"default_import_route_filter,omitempty"`
+}
+
+func ResourceIBMDLGatewayImportRouteFilter() *schema.Resource {
+	return resourceIBMDLGatewayRouteFilter(dlImportRouteFilters)
+}
+
+func ResourceIBMDLGatewayExportRouteFilter() *schema.Resource {
+	return resourceIBMDLGatewayRouteFilter(dlExportRouteFilters)
+}
+
+// resourceIBMDLGatewayRouteFilter is shared by the import and export route
+// filters, filterType is the collection they are managed in.
+func resourceIBMDLGatewayRouteFilter(filterType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIBMdlGatewayRouteFilterCreate(d, meta, filterType)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIBMdlGatewayRouteFilterRead(d, meta, filterType)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIBMdlGatewayRouteFilterUpdate(d, meta, filterType)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIBMdlGatewayRouteFilterDelete(d, meta, filterType)
+		},
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			dlGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Direct Link gateway identifier",
+			},
+			dlRouteFilterId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the route filter",
+			},
+			dlAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_gateway_route_filter", dlAction),
+				Description:  "Whether to permit or deny the routes that match the route filter",
+			},
+			dlPrefix: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IP prefix the route filter matches",
+			},
+			dlBefore: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Id of the route filter this route filter is evaluated before, when not set the route filter is evaluated last",
+			},
+			dlGe: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_gateway_route_filter", dlGe),
+				Description:  "The minimum prefix length of the routes matched, it must be longer than the length of prefix",
+			},
+			dlLe: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_gateway_route_filter", dlLe),
+				Description:  "The maximum prefix length of the routes matched, it must be longer than the length of prefix and not shorter than ge",
+			},
+			dlCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the route filter was created",
+			},
+			dlUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the route filter was last updated",
+			},
+		},
+	}
+}
+
+func ResourceIBMDLGatewayRouteFilterValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "permit, deny"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlGe,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "32"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlLe,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "32"})
+
+	ibmDLGatewayRouteFilterResourceValidator := validate.ResourceValidator{ResourceName: "ibm_dl_gateway_route_filter", Schema: validateSchema}
+	return &ibmDLGatewayRouteFilterResourceValidator
+}
+
+func resourceIBMdlGatewayRouteFilterCreate(d *schema.ResourceData, meta interface{}, filterType string) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	gatewayId := d.Get(dlGatewayId).(string)
+	providerManaged, err := isDirectLinkGatewayChangeAllowed(directLink, gatewayId)
+	if err != nil {
+		return err
+	}
+
+	routeFilterTemplate := &dlRouteFilter{
+		Action: NewStrPointer(d.Get(dlAction).(string)),
+		Prefix: NewStrPointer(d.Get(dlPrefix).(string)),
+	}
+	if before, ok := d.GetOk(dlBefore); ok {
+		routeFilterTemplate.Before = NewStrPointer(before.(string))
+	}
+	if ge, ok := d.GetOk(dlGe); ok {
+		routeFilterTemplate.Ge = NewInt64Pointer(int64(ge.(int)))
+	}
+	if le, ok := d.GetOk(dlLe); ok {
+		routeFilterTemplate.Le = NewInt64Pointer(int64(le.(int)))
+	}
+
+	routeFilter := &dlRouteFilter{}
+	response, err := directLinkRouteFilterRequest(directLink, core.POST, gatewayId, filterType, "", routeFilterTemplate, routeFilter)
+	if err != nil {
+		log.Printf("[DEBUG] Create Direct Link Gateway %s err %s\n%s", filterType, err, response)
+		return fmt.Errorf("[ERROR] Create Direct Link Gateway(%s) %s err %s\n%s", gatewayId, filterType, err, response)
+	}
+	if routeFilter.ID == nil {
+		return fmt.Errorf("[ERROR] Create Direct Link Gateway(%s) %s returned no route filter ID\n%s", gatewayId, filterType, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *routeFilter.ID))
+
+	if providerManaged {
+		_, err = isWaitForDirectLinkGatewayChangeRequest(directLink, gatewayId, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = resourceIBMdlGatewayRouteFilterRead(d, meta, filterType)
+	if err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("[ERROR] The %s change of Direct Link Gateway(%s) was rejected by the provider", filterType, gatewayId)
+	}
+	return nil
+}
+
+func resourceIBMdlGatewayRouteFilterRead(d *schema.ResourceData, meta interface{}, filterType string) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	gatewayId := parts[0]
+	routeFilterId := parts[1]
+
+	routeFilter := &dlRouteFilter{}
+	response, err := directLinkRouteFilterRequest(directLink, core.GET, gatewayId, filterType, routeFilterId, nil, routeFilter)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error fetching Direct Link Gateway(%s) %s err %s\n%s", gatewayId, filterType, err, response)
+	}
+
+	d.Set(dlGatewayId, gatewayId)
+	d.Set(dlRouteFilterId, routeFilter.ID)
+	d.Set(dlAction, routeFilter.Action)
+	d.Set(dlPrefix, routeFilter.Prefix)
+	d.Set(dlBefore, routeFilter.Before)
+	if routeFilter.Ge != nil {
+		d.Set(dlGe, *routeFilter.Ge)
+	} else {
+		d.Set(dlGe, nil)
+	}
+	if routeFilter.Le != nil {
+		d.Set(dlLe, *routeFilter.Le)
+	} else {
+		d.Set(dlLe, nil)
+	}
+	d.Set(dlCreatedAt, routeFilter.CreatedAt)
+	d.Set(dlUpdatedAt, routeFilter.UpdatedAt)
+
+	return nil
+}
+
+func resourceIBMdlGatewayRouteFilterUpdate(d *schema.ResourceData, meta interface{}, filterType string) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	gatewayId := parts[0]
+	routeFilterId := parts[1]
+
+	// A patch with a null ge or le removes that prefix length bound.
+	routeFilterPatch := map[string]interface{}{}
+	if d.HasChange(dlAction) {
+		routeFilterPatch[dlAction] = d.Get(dlAction).(string)
+	}
+	if d.HasChange(dlPrefix) {
+		routeFilterPatch[dlPrefix] = d.Get(dlPrefix).(string)
+	}
+	if before, ok := d.GetOk(dlBefore); ok && d.HasChange(dlBefore) {
+		routeFilterPatch[dlBefore] = before.(string)
+	}
+	if d.HasChange(dlGe) {
+		routeFilterPatch[dlGe] = nil
+		if ge, ok := d.GetOk(dlGe); ok {
+			routeFilterPatch[dlGe] = ge.(int)
+		}
+	}
+	if d.HasChange(dlLe) {
+		routeFilterPatch[dlLe] = nil
+		if le, ok := d.GetOk(dlLe); ok {
+			routeFilterPatch[dlLe] = le.(int)
+		}
+	}
+
+	if len(routeFilterPatch) > 0 {
+		providerManaged, err := isDirectLinkGatewayChangeAllowed(directLink, gatewayId)
+		if err != nil {
+			return err
+		}
+
+		response, err := directLinkRouteFilterRequest(directLink, core.PATCH, gatewayId, filterType, routeFilterId, routeFilterPatch, nil)
+		if err != nil {
+			log.Printf("[DEBUG] Update Direct Link Gateway %s err %s\n%s", filterType, err, response)
+			return fmt.Errorf("[ERROR] Update Direct Link Gateway(%s) %s(%s) err %s\n%s", gatewayId, filterType, routeFilterId, err, response)
+		}
+
+		if providerManaged {
+			_, err = isWaitForDirectLinkGatewayChangeRequest(directLink, gatewayId, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceIBMdlGatewayRouteFilterRead(d, meta, filterType)
+}
+
+func resourceIBMdlGatewayRouteFilterDelete(d *schema.ResourceData, meta interface{}, filterType string) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	gatewayId := parts[0]
+	routeFilterId := parts[1]
+
+	providerManaged, err := isDirectLinkGatewayChangeAllowed(directLink, gatewayId)
+	if err != nil {
+		return err
+	}
+
+	response, err := directLinkRouteFilterRequest(directLink, core.DELETE, gatewayId, filterType, routeFilterId, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error deleting Direct Link Gateway %s : %s", filterType, response)
+		return err
+	}
+
+	if providerManaged {
+		_, err = isWaitForDirectLinkGatewayChangeRequest(directLink, gatewayId, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// isDirectLinkGatewayChangeAllowed reports whether the gateway is managed by
+// a provider. Changes to provider managed gateways are sent to the provider as
+// a change request, only one change request can be pending at a time.
+func isDirectLinkGatewayChangeAllowed(client *directlinkv1.DirectLinkV1, gatewayId string) (bool, error) {
+	gateway, response, err := client.GetGateway(&directlinkv1.GetGatewayOptions{ID: &gatewayId})
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error Getting Direct Link Gateway(%s): %s\n%s", gatewayId, err, response)
+	}
+	if gateway.ProviderApiManaged == nil || !*gateway.ProviderApiManaged {
+		return false, nil
+	}
+	if gateway.ChangeRequest != nil {
+		changeRequestType := ""
+		if changeRequest, ok := gateway.ChangeRequest.(*directlinkv1.GatewayChangeRequest); ok && changeRequest.Type != nil {
+			changeRequestType = *changeRequest.Type
+		}
+		return true, fmt.Errorf("[ERROR] Direct Link Gateway(%s) has a pending %s change request, it must be approved or rejected by the provider first", gatewayId, changeRequestType)
+	}
+	return true, nil
+}
+
+func isWaitForDirectLinkGatewayChangeRequest(client *directlinkv1.DirectLinkV1, gatewayId string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the provider to approve or reject the change request of direct link gateway (%s)", gatewayId)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", dlChangeRequestPending},
+		Target:     []string{dlChangeRequestDone},
+		Refresh:    isDirectLinkGatewayChangeRequestRefreshFunc(client, gatewayId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func isDirectLinkGatewayChangeRequestRefreshFunc(client *directlinkv1.DirectLinkV1, gatewayId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gateway, response, err := client.GetGateway(&directlinkv1.GetGatewayOptions{ID: &gatewayId})
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link Gateway(%s): %s\n%s", gatewayId, err, response)
+		}
+		if gateway.ChangeRequest != nil {
+			return gateway, dlChangeRequestPending, nil
+		}
+		return gateway, dlChangeRequestDone, nil
+	}
+}
+
+// directLinkRouteFilterRequest calls the route filter collection of a
+// gateway, or one route filter of it when routeFilterId is set.
+func directLinkRouteFilterRequest(client *directlinkv1.DirectLinkV1, method string, gatewayId string, filterType string, routeFilterId string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	pathParamsMap := map[string]string{
+		"gateway_id": gatewayId,
+	}
+	path := `/gateways/{gateway_id}/` + filterType
+	if routeFilterId != "" {
+		pathParamsMap["id"] = routeFilterId
+		path += `/{id}`
+	}
+	return directLinkRequest(client, method, path, pathParamsMap, body, result)
+}
+
+func getDirectLinkGatewayRouteFilterDefaults(client *directlinkv1.DirectLinkV1, gatewayId string) (*dlGatewayRouteFilterDefaults, *core.DetailedResponse, error) {
+	defaults := &dlGatewayRouteFilterDefaults{}
+	response, err := directLinkRequest(client, core.GET, `/gateways/{id}`, map[string]string{"id": gatewayId}, nil, defaults)
+	return defaults, response, err
+}
+
+func updateDirectLinkGatewayRouteFilterDefaults(client *directlinkv1.DirectLinkV1, gatewayId string, defaults *dlGatewayRouteFilterDefaults) (*core.DetailedResponse, error) {
+	return directLinkRequest(client, core.PATCH, `/gateways/{id}`, map[string]string{"id": gatewayId}, defaults, nil)
+}
+
+// setDirectLinkGatewayRouteFilterDefaults updates the default route filter actions
+// and, like the route filters, waits for the provider to approve or reject the change
+// request of a provider managed gateway.
+func setDirectLinkGatewayRouteFilterDefaults(client *directlinkv1.DirectLinkV1, gatewayId string, defaults *dlGatewayRouteFilterDefaults, timeout time.Duration) error {
+	providerManaged, err := isDirectLinkGatewayChangeAllowed(client, gatewayId)
+	if err != nil {
+		return err
+	}
+	response, err := updateDirectLinkGatewayRouteFilterDefaults(client, gatewayId, defaults)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting the default route filters of Direct Link Gateway(%s) err %s\n%s", gatewayId, err, response)
+	}
+	if providerManaged {
+		_, err = isWaitForDirectLinkGatewayChangeRequest(client, gatewayId, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func directLinkRequest(client *directlinkv1.DirectLinkV1, method string, path string, pathParamsMap map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", fmt.Sprint(*client.Version))
+
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Service.Request(request, result)
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMDLGatewayRouteFilterResource_basic(t *testing.T) {
+	importNode := "ibm_dl_gateway_import_route_filter.dl_import_route_filter"
+	exportNode := "ibm_dl_gateway_export_route_filter.dl_export_route_filter"
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDLGatewayRouteFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayRouteFilterResourceConfig(gatewayname, "permit", 25),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(importNode, "route_filter_id"),
+					resource.TestCheckResourceAttr(importNode, "action", "permit"),
+					resource.TestCheckResourceAttr(importNode, "prefix", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr(importNode, "ge", "17"),
+					resource.TestCheckResourceAttr(importNode, "le", "25"),
+					resource.TestCheckResourceAttrSet(exportNode, "route_filter_id"),
+					resource.TestCheckResourceAttr(exportNode, "action", "deny"),
+					resource.TestCheckResourceAttr("ibm_dl_gateway.test_dl_gateway", "default_import_route_filter", "deny"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDLGatewayRouteFilterResourceConfig(gatewayname, "deny", 28),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(importNode, "action", "deny"),
+					resource.TestCheckResourceAttr(importNode, "le", "28"),
+				),
+			},
+			{
+				ResourceName:      importNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLGatewayRouteFilterResourceConfig(gatewayname string, action string, le int) string {
+	return fmt.Sprintf(`
+	data "ibm_dl_ports" "ds_dlports" {
+	}
+
+	resource ibm_dl_gateway test_dl_gateway {
+		bgp_asn =  64999
+		global = true
+		metered = false
+		name = "%s"
+		speed_mbps = 1000
+		type =  "connect"
+		port = data.ibm_dl_ports.ds_dlports.ports[0].port_id
+		default_import_route_filter = "deny"
+	}
+
+	resource ibm_dl_gateway_import_route_filter dl_import_route_filter {
+		gateway = ibm_dl_gateway.test_dl_gateway.id
+		action  = "%s"
+		prefix  = "10.10.0.0/16"
+		ge      = 17
+		le      = %d
+	}
+
+	resource ibm_dl_gateway_export_route_filter dl_export_route_filter {
+		gateway = ibm_dl_gateway.test_dl_gateway.id
+		action  = "deny"
+		prefix  = "192.168.0.0/24"
+	}
+	`, gatewayname, action, le)
+}
+
+func testAccCheckIBMDLGatewayRouteFilterDestroy(s *terraform.State) error {
+	client, err := directlinkClient(acc.TestAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_dl_gateway_import_route_filter" && rs.Type != "ibm_dl_gateway_export_route_filter" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		gatewayId := parts[0]
+
+		// The route filters are removed with their gateway.
+		getGatewayOptions := &directlinkv1.GetGatewayOptions{}
+		getGatewayOptions.SetID(gatewayId)
+		_, _, err = client.GetGateway(getGatewayOptions)
+		if err == nil {
+			return fmt.Errorf(" DL gateway of route filter still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -4,104 +4,9 @@
 package eventnotification
 
 import (
-	"context"
-	"fmt"
-
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
 )
 
 func DataSourceIBMEnFCMSubscription() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceIBMEnFCMSubscriptionRead,
-
-		Schema: map[string]*schema.Schema{
-			"instance_guid": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
-			},
-			"subscription_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Unique identifier for result.",
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Subscription name.",
-			},
-			"description": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Subscription description.",
-			},
-			"destination_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The destination ID.",
-			},
-			"topic_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Topic ID.",
-			},
-			"updated_at": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Last updated time.",
-			},
-		},
-	}
-}
-
-func dataSourceIBMEnFCMSubscriptionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	getSubscriptionOptions := &en.GetSubscriptionOptions{}
-
-	getSubscriptionOptions.SetInstanceID(d.Get("instance_guid").(string))
-	getSubscriptionOptions.SetID(d.Get("subscription_id").(string))
-
-	result, response, err := enClient.GetSubscriptionWithContext(context, getSubscriptionOptions)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
-	}
-
-	d.SetId(fmt.Sprintf("%s/%s", *getSubscriptionOptions.InstanceID, *getSubscriptionOptions.ID))
-
-	if err = d.Set("name", result.Name); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
-	}
-
-	if result.Description != nil {
-		if err = d.Set("description", result.Description); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
-		}
-	}
-	if err = d.Set("updated_at", result.UpdatedAt); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
-	}
-
-	if err = d.Set("destination_id", result.DestinationID); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error setting destination_id: %s", err))
-	}
-
-	if err = d.Set("topic_id", result.TopicID); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error setting topic_id: %s", err))
-	}
-
-	if result.From != nil {
-		if err = d.Set("from", result.From); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error setting from %s", err))
-		}
-	}
-
-	return nil
+	return dataSourceIBMEnGenericSubscription("push_android")
 }
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+func DataSourceIBMEnAPNSSubscription() *schema.Resource {
+	return dataSourceIBMEnGenericSubscription("push_ios")
+}
+
+func DataSourceIBMEnChromeSubscription() *schema.Resource {
+	return dataSourceIBMEnGenericSubscription("push_chrome")
+}
+
+func DataSourceIBMEnFirefoxSubscription() *schema.Resource {
+	return dataSourceIBMEnGenericSubscription("push_firefox")
+}
+
+func DataSourceIBMEnSafariSubscription() *schema.Resource {
+	return dataSourceIBMEnGenericSubscription("push_safari")
+}
+
+func DataSourceIBMEnMSTeamsSubscription() *schema.Resource {
+	return dataSourceIBMEnGenericSubscription("msteams")
+}
+
+func DataSourceIBMEnCFSubscription() *schema.Resource {
+	return dataSourceIBMEnGenericSubscription("ibmcf")
+}
+
+func DataSourceIBMEnPagerDutySubscription() *schema.Resource {
+	return dataSourceIBMEnGenericSubscription("pagerduty")
+}
+
+func dataSourceIBMEnGenericSubscription(destinationType string) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return dataSourceIBMEnGenericSubscriptionRead(context, d, meta, destinationType)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier for result.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subscription name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subscription description.",
+			},
+			"destination_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The destination ID.",
+			},
+			"destination_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of Destination.",
+			},
+			"destination_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Destintion name.",
+			},
+			"topic_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Topic ID.",
+			},
+			"topic_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the topic.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMEnGenericSubscriptionRead(context context.Context, d *schema.ResourceData, meta interface{}, destinationType string) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSubscriptionOptions := &en.GetSubscriptionOptions{}
+
+	getSubscriptionOptions.SetInstanceID(d.Get("instance_guid").(string))
+	getSubscriptionOptions.SetID(d.Get("subscription_id").(string))
+
+	result, response, err := enClient.GetSubscriptionWithContext(context, getSubscriptionOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+	}
+
+	if result.DestinationType == nil || !strings.EqualFold(*result.DestinationType, destinationType) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Subscription %s is for a destination of type %s, not %s", *getSubscriptionOptions.ID, core.StringNilMapper(result.DestinationType), destinationType))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *getSubscriptionOptions.InstanceID, *getSubscriptionOptions.ID))
+
+	return setIBMEnGenericSubscription(d, result)
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+// testAccIBMEnGenericSubscriptions are the subscription types that share the generic
+// subscription implementation, with the settings of a destination of each type.
+var testAccIBMEnGenericSubscriptions = []struct {
+	subscriptionType string
+	destinationType  string
+	destination      string
+}{
+	{
+		subscriptionType: "cf",
+		destinationType:  "ibmcf",
+		destination: `
+		config {
+			params {
+				url  = "https://www.ibmcfendpoint.com/"
+				api_key = "ddgheueiinbjjkeoeooejbbsvwye2wu"
+			}
+		}`,
+	},
+	{
+		subscriptionType: "chrome",
+		destinationType:  "push_chrome",
+		destination: `
+		config {
+			params {
+				api_key = "vvshlgwwfvjj"
+				website_url = "https://testweb.com"
+				pre_prod = false
+			}
+		}`,
+	},
+	{
+		subscriptionType: "firefox",
+		destinationType:  "push_firefox",
+		destination: `
+		config {
+			params {
+				website_url = "https://testweb.com"
+				pre_prod = false
+			}
+		}`,
+	},
+	{
+		subscriptionType: "ios",
+		destinationType:  "push_ios",
+		destination: `
+		certificate_content_type = "p12"
+		certificate = "${path.module}/cert.p12"
+		config {
+			params {
+				cert_type = "p12"
+				is_sandbox = true
+				password = "certpassword"
+				pre_prod = false
+			}
+		}`,
+	},
+	{
+		subscriptionType: "msteams",
+		destinationType:  "msteams",
+		destination: `
+		config {
+			params {
+				url  = "https://xyz.webhook.office.com"
+			}
+		}`,
+	},
+	{
+		subscriptionType: "pagerduty",
+		destinationType:  "pagerduty",
+		destination: `
+		config {
+			params {
+				routing_key  = "33220320pgdpgpewwp"
+				api_key = "dwvdouqufqwojji"
+			}
+		}`,
+	},
+	{
+		subscriptionType: "safari",
+		destinationType:  "push_safari",
+		destination: `
+		certificate                  = "${path.module}/cert.p12"
+		icon_16x16                   = "${path.module}/safariicon.png"
+		icon_16x16_2x                = "${path.module}/safariicon.png"
+		icon_32x32                   = "${path.module}/safariicon.png"
+		icon_32x32_2x                = "${path.module}/safariicon.png"
+		icon_128x128                 = "${path.module}/safariicon.png"
+		icon_128x128_2x              = "${path.module}/safariicon.png"
+		icon_16x16_content_type      = "png"
+		icon_16x16_2x_content_type   = "png"
+		icon_32x32_content_type      = "png"
+		icon_32x32_2x_content_type   = "png"
+		icon_128x128_content_type    = "png"
+		icon_128x128_2x_content_type = "png"
+		config {
+			params {
+				cert_type = "p12"
+				password = "certpassword"
+				website_name = "NodeJS Starter Application"
+				url_format_string = "https://ensafaripush.mybluemix.net"
+				website_push_id = "web.net.mybluemix.ensafaripush"
+				website_url = "https://ensafaripush.mybluemix.net"
+				pre_prod = false
+			}
+		}`,
+	},
+}
+
+func TestAccIBMEnGenericSubscriptionAllArgs(t *testing.T) {
+	for _, c := range testAccIBMEnGenericSubscriptions {
+		t.Run(c.subscriptionType, func(t *testing.T) {
+			var conf en.Subscription
+			instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+			name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+			description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+			newName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+			newDescription := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+			resourceName := fmt.Sprintf("ibm_en_subscription_%s.en_subscription_resource_1", c.subscriptionType)
+			dataSourceName := fmt.Sprintf("data.ibm_en_subscription_%s.data_subscription_1", c.subscriptionType)
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:     func() { acc.TestAccPreCheck(t) },
+				Providers:    acc.TestAccProviders,
+				CheckDestroy: testAccCheckIBMEnFCMSubscriptionDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccCheckIBMEnGenericSubscriptionConfig(c.subscriptionType, c.destinationType, c.destination, instanceName, name, description),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							testAccCheckIBMEnFCMSubscriptionExists(resourceName, conf),
+							resource.TestCheckResourceAttr(resourceName, "name", name),
+							resource.TestCheckResourceAttr(resourceName, "description", description),
+							resource.TestCheckResourceAttrSet(resourceName, "topic_id"),
+							resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+							resource.TestCheckResourceAttrSet(resourceName, "instance_guid"),
+							resource.TestCheckResourceAttrSet(resourceName, "destination_id"),
+							resource.TestCheckResourceAttrSet(resourceName, "subscription_id"),
+							resource.TestCheckResourceAttr(resourceName, "destination_type", c.destinationType),
+							resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+							resource.TestCheckResourceAttr(dataSourceName, "name", name),
+							resource.TestCheckResourceAttr(dataSourceName, "destination_type", c.destinationType),
+							resource.TestCheckResourceAttrSet(dataSourceName, "destination_name"),
+							resource.TestCheckResourceAttrSet(dataSourceName, "topic_name"),
+						),
+					},
+					{
+						Config: testAccCheckIBMEnGenericSubscriptionConfig(c.subscriptionType, c.destinationType, c.destination, instanceName, newName, newDescription),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "name", newName),
+							resource.TestCheckResourceAttr(resourceName, "description", newDescription),
+						),
+					},
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateVerify: false,
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckIBMEnGenericSubscriptionConfig(subscriptionType, destinationType, destination, instanceName, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_subscription_resource" {
+		name     = "%[4]s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_topic" "en_topic_resource_2" {
+		instance_guid = ibm_resource_instance.en_subscription_resource.guid
+		name        = "tf_topic_name_0234"
+		description = "tf_topic_description_0235"
+	}
+
+	resource "ibm_en_destination_%[1]s" "en_destination_resource_2" {
+		instance_guid = ibm_resource_instance.en_subscription_resource.guid
+		name        = "tf_destination_name_02983"
+		type        = "%[2]s"
+		description = "tf_destinatios_description_0364"
+		%[3]s
+	}
+
+	resource "ibm_en_subscription_%[1]s" "en_subscription_resource_1" {
+		name           = "%[5]s"
+		description    = "%[6]s"
+		instance_guid  = ibm_resource_instance.en_subscription_resource.guid
+		topic_id       = ibm_en_topic.en_topic_resource_2.topic_id
+		destination_id = ibm_en_destination_%[1]s.en_destination_resource_2.destination_id
+	}
+
+	data "ibm_en_subscription_%[1]s" "data_subscription_1" {
+		instance_guid   = ibm_en_subscription_%[1]s.en_subscription_resource_1.instance_guid
+		subscription_id = ibm_en_subscription_%[1]s.en_subscription_resource_1.subscription_id
+	}
+	`, subscriptionType, destinationType, destination, instanceName, name, description)
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEventStreamsConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMEventStreamsConsumerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the consumer group with this ID",
+			},
+			"consumer_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The consumer groups of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the consumer group",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the consumer group, such as Stable or Empty",
+						},
+						"members": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members in the consumer group",
+						},
+						"total_lag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The sum of the lag of all partitions the group has committed offsets for",
+						},
+						"partitions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The committed offsets and lag of the group per partition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the topic",
+									},
+									"partition": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The partition number",
+									},
+									"committed_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset committed by the group",
+									},
+									"end_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset of the next message produced to the partition",
+									},
+									"lag": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of messages the group has not consumed yet",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsConsumerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	client, instanceCRN, err := createSaramaClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead createSaramaClient err %s", err)
+		return err
+	}
+	adminClient, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		client.Close()
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead NewClusterAdminFromClient err %s", err)
+		return err
+	}
+	// Closing the admin client closes the underlying client too.
+	defer adminClient.Close()
+
+	var groupIDs []string
+	if groupID, ok := d.GetOk("group_id"); ok {
+		groupIDs = []string{groupID.(string)}
+	} else {
+		groups, err := adminClient.ListConsumerGroups()
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead ListConsumerGroups err %s", err)
+			return err
+		}
+		for groupID := range groups {
+			groupIDs = append(groupIDs, groupID)
+		}
+		sort.Strings(groupIDs)
+	}
+
+	descriptions := []*sarama.GroupDescription{}
+	if len(groupIDs) > 0 {
+		descriptions, err = adminClient.DescribeConsumerGroups(groupIDs)
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead DescribeConsumerGroups err %s", err)
+			return err
+		}
+	}
+
+	consumerGroups := make([]map[string]interface{}, 0, len(descriptions))
+	for _, description := range descriptions {
+		if description.Err != sarama.ErrNoError {
+			return fmt.Errorf("failed to describe consumer group %s: %s", description.GroupId, description.Err)
+		}
+		partitions, totalLag, err := getConsumerGroupLag(client, adminClient, description.GroupId)
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead getConsumerGroupLag err %s", err)
+			return err
+		}
+		consumerGroups = append(consumerGroups, map[string]interface{}{
+			"group_id":   description.GroupId,
+			"state":      description.State,
+			"members":    len(description.Members),
+			"total_lag":  int(totalLag),
+			"partitions": partitions,
+		})
+	}
+
+	d.SetId(instanceCRN)
+	d.Set("resource_instance_id", instanceCRN)
+	if err = d.Set("consumer_groups", consumerGroups); err != nil {
+		return fmt.Errorf("[ERROR] Error setting consumer_groups: %s", err)
+	}
+	return nil
+}
+
+// getConsumerGroupLag compares the offsets committed by the group with the
+// latest offsets of the partitions.
+func getConsumerGroupLag(client sarama.Client, adminClient sarama.ClusterAdmin, groupID string) ([]map[string]interface{}, int64, error) {
+	offsets, err := adminClient.ListConsumerGroupOffsets(groupID, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offsets.Err != sarama.ErrNoError {
+		return nil, 0, fmt.Errorf("failed to list the offsets of consumer group %s: %s", groupID, offsets.Err)
+	}
+
+	topics := make([]string, 0, len(offsets.Blocks))
+	for topic := range offsets.Blocks {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	partitions := []map[string]interface{}{}
+	var totalLag int64
+	for _, topic := range topics {
+		partitionIDs := make([]int, 0, len(offsets.Blocks[topic]))
+		for partition := range offsets.Blocks[topic] {
+			partitionIDs = append(partitionIDs, int(partition))
+		}
+		sort.Ints(partitionIDs)
+
+		for _, partition := range partitionIDs {
+			block := offsets.Blocks[topic][int32(partition)]
+			// Partitions without a committed offset are reported with offset -1.
+			if block.Err != sarama.ErrNoError || block.Offset < 0 {
+				continue
+			}
+			endOffset, err := client.GetOffset(topic, int32(partition), sarama.OffsetNewest)
+			if err != nil {
+				return nil, 0, err
+			}
+			lag := endOffset - block.Offset
+			if lag < 0 {
+				lag = 0
+			}
+			totalLag += lag
+			partitions = append(partitions, map[string]interface{}{
+				"topic":            topic,
+				"partition":        partition,
+				"committed_offset": int(block.Offset),
+				"end_offset":       int(endOffset),
+				"lag":              int(lag),
+			})
+		}
+	}
+	return partitions, totalLag, nil
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsConsumerGroupsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupsDataSourceConfig(standardInstanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "kafka_http_url"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "consumer_groups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsConsumerGroupsDataSourceConfig(instanceName string) string {
+	return getPlatformResource(instanceName) + "\n" + `
+	data "ibm_event_streams_consumer_groups" "es_consumer_groups" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+	}`
+}
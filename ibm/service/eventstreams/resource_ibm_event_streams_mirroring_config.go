@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMEventStreamsMirroringConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsMirroringConfigUpdate,
+		ReadContext:   resourceIBMEventStreamsMirroringConfigRead,
+		UpdateContext: resourceIBMEventStreamsMirroringConfigUpdate,
+		DeleteContext: resourceIBMEventStreamsMirroringConfigDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance that mirroring is enabled on",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"mirroring_topic_patterns": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The topic names or regular expressions of the topics mirrored from the source instance",
+			},
+			"active_topics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The topics that are being actively mirrored",
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsMirroringConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getEnterpriseInstanceURL(d, meta, "mirroring")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient.SetServiceURL(adminURL)
+
+	if d.IsNewResource() || d.HasChange("mirroring_topic_patterns") {
+		replaceOptions := &adminrestv1.ReplaceMirroringTopicSelectionOptions{}
+		replaceOptions.SetIncludes(flex.ExpandStringList(d.Get("mirroring_topic_patterns").([]interface{})))
+
+		_, response, err := adminrestClient.ReplaceMirroringTopicSelectionWithContext(context, replaceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ReplaceMirroringTopicSelectionWithContext failed with error: %s and response: \n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ReplaceMirroringTopicSelectionWithContext failed with error: %s and response: \n%s", err, response))
+		}
+	}
+	d.SetId(getMirroringConfigID(instanceCRN))
+
+	return resourceIBMEventStreamsMirroringConfigRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsMirroringConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getEnterpriseInstanceURL(d, meta, "mirroring")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient.SetServiceURL(adminURL)
+
+	selection, response, err := adminrestClient.GetMirroringTopicSelectionWithContext(context, &adminrestv1.GetMirroringTopicSelectionOptions{})
+	if err != nil || selection == nil {
+		log.Printf("[DEBUG] GetMirroringTopicSelectionWithContext failed with error: %s and response: \n%s", err, response)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetMirroringTopicSelectionWithContext failed %s\n%s", err, response))
+	}
+
+	activeTopics, response, err := adminrestClient.GetMirroringActiveTopicsWithContext(context, &adminrestv1.GetMirroringActiveTopicsOptions{})
+	if err != nil || activeTopics == nil {
+		log.Printf("[DEBUG] GetMirroringActiveTopicsWithContext failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetMirroringActiveTopicsWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("resource_instance_id", instanceCRN)
+	if err = d.Set("mirroring_topic_patterns", selection.Includes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting mirroring_topic_patterns: %s", err))
+	}
+	if err = d.Set("active_topics", activeTopics.ActiveTopics); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting active_topics: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMEventStreamsMirroringConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, _, err := getEnterpriseInstanceURL(d, meta, "mirroring")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient.SetServiceURL(adminURL)
+
+	// Mirroring itself is enabled on the instance, deleting the resource stops
+	// mirroring all topics.
+	replaceOptions := &adminrestv1.ReplaceMirroringTopicSelectionOptions{}
+	replaceOptions.SetIncludes([]string{})
+
+	_, response, err := adminrestClient.ReplaceMirroringTopicSelectionWithContext(context, replaceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ReplaceMirroringTopicSelectionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ReplaceMirroringTopicSelectionWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getMirroringConfigID(instanceCRN string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "mirroring"
+	crnSegments[9] = "topic-selection"
+	return strings.Join(crnSegments, ":")
+}
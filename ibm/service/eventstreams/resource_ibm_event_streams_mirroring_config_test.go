@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsMirroringConfigResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfigWithExistingInstance(MZREnterpriseInstanceName, `"topic-a", "topic-b"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_mirroring_config.es_mirroring_config", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.#", "2"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.0", "topic-a"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfigWithExistingInstance(MZREnterpriseInstanceName, `"topic-.*"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.#", "1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.0", "topic-.*"),
+				),
+			},
+			{
+				ResourceName:            "ibm_event_streams_mirroring_config.es_mirroring_config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"active_topics"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsMirroringConfigWithExistingInstance(instanceName string, patterns string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_mirroring_config" "es_mirroring_config" {
+		resource_instance_id     = data.ibm_resource_instance.es_instance.id
+		mirroring_topic_patterns = [%s]
+	}`, patterns)
+}
@@ -0,0 +1,273 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// noQuota is the byte rate reported for a quota direction that is not set.
+const noQuota = -1
+
+// EventStreamsQuota is the body of the admin REST quota API. The admin REST SDK v1.2.0
+// this provider is built with has no quota operations, replace the requests
+// with CreateQuota, GetQuota, UpdateQuota and DeleteQuota once it is bumped.
+type EventStreamsQuota struct {
+	ProducerByteRate *int64 `json:"producer_byte_rate,omitempty"`
+	ConsumerByteRate *int64 `json:"consumer_byte_rate,omitempty"`
+}
+
+func ResourceIBMEventStreamsQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsQuotaCreate,
+		ReadContext:   resourceIBMEventStreamsQuotaRead,
+		UpdateContext: resourceIBMEventStreamsQuotaUpdate,
+		DeleteContext: resourceIBMEventStreamsQuotaDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"entity": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity the quota applies to, either `default` or an IAM service ID such as `iam-ServiceId-00001111-2222-3333-4444-555566667777`",
+			},
+			"producer_byte_rate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     noQuota,
+				Description: "The producer quota in bytes per second, -1 means no producer quota",
+			},
+			"consumer_byte_rate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     noQuota,
+				Description: "The consumer quota in bytes per second, -1 means no consumer quota",
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsQuotaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getEnterpriseInstanceURL(d, meta, "quotas")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient.SetServiceURL(adminURL)
+
+	entity := d.Get("entity").(string)
+	quota := ExpandEventStreamsQuota(d.Get("producer_byte_rate").(int), d.Get("consumer_byte_rate").(int))
+	if quota.ProducerByteRate == nil && quota.ConsumerByteRate == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] At least one of producer_byte_rate and consumer_byte_rate must be set for the quota of %s", entity))
+	}
+
+	response, err := EventStreamsQuotaRequest(context, adminrestClient, core.POST, entity, quota, nil)
+	if err != nil {
+		log.Printf("[DEBUG] CreateQuotaWithContext failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateQuotaWithContext failed with error: %s and response: \n%s", err, response))
+	}
+	d.SetId(getQuotaID(instanceCRN, entity))
+
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := GetQuotaEntity(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getEnterpriseInstanceURL(d, meta, "quotas")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient.SetServiceURL(adminURL)
+
+	quota := &EventStreamsQuota{}
+	response, err := EventStreamsQuotaRequest(context, adminrestClient, core.GET, entity, nil, quota)
+	if err != nil {
+		log.Printf("[DEBUG] GetQuotaWithContext failed with error: %s and response: \n%s", err, response)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetQuotaWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("entity", entity)
+	d.Set("producer_byte_rate", flattenEventStreamsByteRate(quota.ProducerByteRate))
+	d.Set("consumer_byte_rate", flattenEventStreamsByteRate(quota.ConsumerByteRate))
+
+	return nil
+}
+
+func resourceIBMEventStreamsQuotaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := GetQuotaEntity(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, _, err := getEnterpriseInstanceURL(d, meta, "quotas")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient.SetServiceURL(adminURL)
+
+	if d.HasChanges("producer_byte_rate", "consumer_byte_rate") {
+		quota := ExpandEventStreamsQuotaUpdate(d.Get("producer_byte_rate").(int), d.Get("consumer_byte_rate").(int))
+		response, err := EventStreamsQuotaRequest(context, adminrestClient, core.PATCH, entity, quota, nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateQuotaWithContext failed with error: %s and response: \n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateQuotaWithContext failed with error: %s and response: \n%s", err, response))
+		}
+	}
+
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := GetQuotaEntity(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, _, err := getEnterpriseInstanceURL(d, meta, "quotas")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminrestClient.SetServiceURL(adminURL)
+
+	response, err := EventStreamsQuotaRequest(context, adminrestClient, core.DELETE, entity, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteQuotaWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// ExpandEventStreamsQuota returns the create body, a direction without quota
+// is left out.
+func ExpandEventStreamsQuota(producerByteRate, consumerByteRate int) *EventStreamsQuota {
+	quota := &EventStreamsQuota{}
+	if producerByteRate != noQuota {
+		quota.ProducerByteRate = core.Int64Ptr(int64(producerByteRate))
+	}
+	if consumerByteRate != noQuota {
+		quota.ConsumerByteRate = core.Int64Ptr(int64(consumerByteRate))
+	}
+	return quota
+}
+
+// ExpandEventStreamsQuotaUpdate returns the update body, which replaces both
+// rates. -1 removes the quota of that direction.
+func ExpandEventStreamsQuotaUpdate(producerByteRate, consumerByteRate int) *EventStreamsQuota {
+	return &EventStreamsQuota{
+		ProducerByteRate: core.Int64Ptr(int64(producerByteRate)),
+		ConsumerByteRate: core.Int64Ptr(int64(consumerByteRate)),
+	}
+}
+
+func flattenEventStreamsByteRate(rate *int64) int {
+	if rate == nil {
+		return noQuota
+	}
+	return int(*rate)
+}
+
+// EventStreamsQuotaRequest calls /admin/quotas/{entity_name} of the admin REST
+// API. The body and the result are optional.
+func EventStreamsQuotaRequest(ctx context.Context, adminrestClient *adminrestv1.AdminrestV1, method string, entity string, body *EventStreamsQuota, result *EventStreamsQuota) (response *core.DetailedResponse, err error) {
+	pathParamsMap := map[string]string{
+		"entity_name": entity,
+	}
+
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = adminrestClient.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(adminrestClient.Service.Options.URL, `/admin/quotas/{entity_name}`, pathParamsMap)
+	if err != nil {
+		return
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	if result != nil {
+		return adminrestClient.Service.Request(request, result)
+	}
+	return adminrestClient.Service.Request(request, nil)
+}
+
+func getQuotaID(instanceCRN string, entity string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "quota"
+	crnSegments[9] = entity
+	return strings.Join(crnSegments, ":")
+}
+
+// GetQuotaEntity returns the entity of a quota ID, which is the instance CRN with
+// quota and the entity as its last two segments.
+func GetQuotaEntity(id string) (string, error) {
+	parts, err := flex.SepIdParts(id, ":")
+	if err != nil {
+		return "", err
+	}
+	if len(parts) != 10 || parts[8] != "quota" || parts[9] == "" {
+		return "", fmt.Errorf("[ERROR] Incorrect ID %s: the ID should be the instance CRN with quota:<entity> as the last two segments", id)
+	}
+	return parts[9], nil
+}
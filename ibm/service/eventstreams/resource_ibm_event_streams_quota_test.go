@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/eventstreams"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMEventStreamsQuotaResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(MZREnterpriseInstanceName, 1048576, -1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_quota.es_quota", "id"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_quota.es_quota", "kafka_http_url"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", "default"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "1048576"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "-1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(MZREnterpriseInstanceName, 2097152, 4194304),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", "default"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "2097152"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "4194304"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_quota.es_quota",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsQuotaWithExistingInstance(instanceName string, producerByteRate int, consumerByteRate int) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_quota" "es_quota" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		entity               = "default"
+		producer_byte_rate   = %d
+		consumer_byte_rate   = %d
+	}`, producerByteRate, consumerByteRate)
+}
+
+func TestExpandEventStreamsQuota(t *testing.T) {
+	testcases := []struct {
+		producerByteRate int
+		consumerByteRate int
+		create           string
+		update           string
+	}{
+		{1024, 2048, `{"producer_byte_rate":1024,"consumer_byte_rate":2048}`, `{"producer_byte_rate":1024,"consumer_byte_rate":2048}`},
+		{1024, -1, `{"producer_byte_rate":1024}`, `{"producer_byte_rate":1024,"consumer_byte_rate":-1}`},
+		{-1, 0, `{"consumer_byte_rate":0}`, `{"producer_byte_rate":-1,"consumer_byte_rate":0}`},
+	}
+
+	for _, c := range testcases {
+		create, _ := json.Marshal(eventstreams.ExpandEventStreamsQuota(c.producerByteRate, c.consumerByteRate))
+		assert.Equal(t, c.create, string(create))
+		update, _ := json.Marshal(eventstreams.ExpandEventStreamsQuotaUpdate(c.producerByteRate, c.consumerByteRate))
+		assert.Equal(t, c.update, string(update))
+	}
+}
+
+func TestGetQuotaEntity(t *testing.T) {
+	entity, err := eventstreams.GetQuotaEntity("crn:v1:bluemix:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:quota:iam-ServiceId-1234")
+	assert.NilError(t, err)
+	assert.Equal(t, "iam-ServiceId-1234", entity)
+
+	for _, id := range []string{"default", "crn:v1:bluemix:public:messagehub:us-south", "crn:v1:bluemix:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:topic:default"} {
+		_, err := eventstreams.GetQuotaEntity(id)
+		assert.ErrorContains(t, err, id)
+	}
+}
+
+func TestEventStreamsQuotaRequest(t *testing.T) {
+	var method, path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := io.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.Path, strings.TrimSpace(string(content))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"producer_byte_rate":1024}`))
+	}))
+	defer server.Close()
+	client, err := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.NilError(t, err)
+
+	_, err = eventstreams.EventStreamsQuotaRequest(context.Background(), client, core.PATCH, "iam-ServiceId-1234", eventstreams.ExpandEventStreamsQuotaUpdate(1024, -1), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, core.PATCH+" /admin/quotas/iam-ServiceId-1234", method+" "+path)
+	assert.Equal(t, `{"producer_byte_rate":1024,"consumer_byte_rate":-1}`, body)
+
+	quota := &eventstreams.EventStreamsQuota{}
+	_, err = eventstreams.EventStreamsQuotaRequest(context.Background(), client, core.GET, "iam-ServiceId-1234", nil, quota)
+	assert.NilError(t, err)
+	assert.Equal(t, core.GET, method)
+	assert.Equal(t, int64(1024), *quota.ProducerByteRate)
+	assert.Assert(t, quota.ConsumerByteRate == nil)
+}
@@ -305,6 +305,12 @@ func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.Reso
 }
 
 func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, error) {
+	return getEnterpriseInstanceURL(d, meta, "schema registry")
+}
+
+// getEnterpriseInstanceURL returns the admin REST URL of an instance for
+// features that are only offered by the enterprise plan.
+func getEnterpriseInstanceURL(d *schema.ResourceData, meta interface{}, feature string) (string, string, error) {
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
 		schemaID := d.Id()
@@ -324,8 +330,8 @@ func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, e
 	planID := *instance.ResourcePlanID
 	valid := strings.Contains(planID, "enterprise")
 	if !valid {
-		return "", "", fmt.Errorf("schema registry is not supported by the Event Streams %s plan, enterprise plan is expected",
-			planID)
+		return "", "", fmt.Errorf("%s is not supported by the Event Streams %s plan, enterprise plan is expected",
+			feature, planID)
 	}
 	d.Set("kafka_http_url", adminURL)
 	log.Printf("[INFO]getInstanceURL kafka_http_url is set to %s", adminURL)
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	attachedOnly = "attached_only"
+	usageCount   = "usage_count"
+	orphanedTags = "orphaned_tags"
+	usageQuery   = "usage_query"
+	maxResources = "max_resources"
+)
+
+func DataSourceIBMTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			tagType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_resource_tag", tagType),
+				Description:  "Tag type of the tags to list",
+				Default:      "user",
+			},
+			attachedOnly: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only list the tags that are attached to at least one resource",
+			},
+			usageQuery: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Global Search query in Lucene syntax that selects the resources whose tags are counted, for example `resource_group_id:<id>`",
+			},
+			maxResources: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10000,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of resources that usage_query may match. The read fails when more resources match",
+			},
+			tags: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the tags of the account",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the tag",
+						},
+						usageCount: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of resources that match usage_query the tag is attached to",
+						},
+					},
+				},
+			},
+			orphanedTags: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the tags that are not attached to any resource that matches usage_query",
+			},
+		},
+	}
+}
+
+func dataSourceIBMTagsRead(d *schema.ResourceData, meta interface{}) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	tType := d.Get(tagType).(string)
+	listTagsOptions := &globaltaggingv1.ListTagsOptions{
+		TagType:      flex.PtrToString(tType),
+		AttachedOnly: core.BoolPtr(d.Get(attachedOnly).(bool)),
+		Limit:        core.Int64Ptr(searchPageSize),
+	}
+	if tType == service {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		listTagsOptions.AccountID = flex.PtrToString(userDetails.UserAccount)
+	}
+
+	tagNames := []string{}
+	for offset := int64(0); ; offset += searchPageSize {
+		listTagsOptions.Offset = core.Int64Ptr(offset)
+		tagList, resp, err := gtClient.ListTags(listTagsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing tags: %s\n%s", err, resp)
+		}
+		for _, tag := range tagList.Items {
+			tagNames = append(tagNames, *tag.Name)
+		}
+		if len(tagList.Items) < searchPageSize {
+			break
+		}
+	}
+
+	// The tagging API does not count the usage of the tags, so the tags of the
+	// resources that match the usage query are counted.
+	resourceTags, err := searchResourceTagsWithLimit(meta, d.Get(usageQuery).(string), tType, d.Get(maxResources).(int))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error searching the tags of the resources: %s", err)
+	}
+	counts := map[string]int{}
+	for _, attached := range resourceTags {
+		for _, t := range attached {
+			counts[t]++
+		}
+	}
+
+	tagItems := make([]map[string]interface{}, 0, len(tagNames))
+	orphaned := []string{}
+	for _, name := range tagNames {
+		tagItems = append(tagItems, map[string]interface{}{
+			"name":     name,
+			usageCount: counts[name],
+		})
+		if counts[name] == 0 {
+			orphaned = append(orphaned, name)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set(tags, tagItems)
+	d.Set(orphanedTags, orphaned)
+	return nil
+}
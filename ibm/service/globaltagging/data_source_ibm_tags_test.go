@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTagsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTagsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_tags.tags", "tags.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_tags.tags", "orphaned_tags.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagsDataSourceConfig() string {
+	return `
+	data "ibm_tags" "tags" {
+		tag_type    = "user"
+		usage_query = "type:resource-instance"
+	}
+`
+}
@@ -0,0 +1,477 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	query                 = "query"
+	matchingResources     = "resources"
+	noncompliantResources = "noncompliant_resources"
+	taggedResources       = "tagged_resources"
+
+	// searchPageSize and tagBatchSize are the maximum page size of the
+	// Global Search API and the maximum number of resources of one attach or
+	// detach request.
+	searchPageSize = 1000
+	tagBatchSize   = 100
+)
+
+func ResourceIBMTagPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMTagPolicyCreate,
+		Read:   resourceIBMTagPolicyRead,
+		Update: resourceIBMTagPolicyUpdate,
+		Delete: resourceIBMTagPolicyDelete,
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMTagPolicyDriftCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			query: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Global Search query in Lucene syntax that selects the resources the tags are attached to, for example `type:resource-instance AND region:us-south`",
+			},
+			tags: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_tag_policy", tags)},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to every resource that matches the query",
+			},
+			tagType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "user",
+				ValidateFunc: validate.InvokeValidator("ibm_tag_policy", tagType),
+				Description:  "Type of the tags. Only allowed values are: user, or access (default value : user)",
+			},
+			matchingResources: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "CRNs of the resources that match the query",
+			},
+			noncompliantResources: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "CRNs of the resources that match the query and miss at least one of the tags. The tags are attached to them on the next apply",
+			},
+			taggedResources: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The tags that the policy attached, as a comma separated list by resource CRN. Only these tags are detached when they are removed from the policy or the policy is destroyed",
+			},
+		},
+	}
+}
+
+func ResourceIBMTagPolicyValidator() *validate.ResourceValidator {
+	tagTypeAllowedValues := "access,user"
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tags,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tagType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              tagTypeAllowedValues})
+
+	ibmTagPolicyValidator := validate.ResourceValidator{ResourceName: "ibm_tag_policy", Schema: validateSchema}
+	return &ibmTagPolicyValidator
+}
+
+// resourceIBMTagPolicyDriftCustomizeDiff plans an update when the last read
+// found resources that miss some of the tags.
+func resourceIBMTagPolicyDriftCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	noncompliant := diff.Get(noncompliantResources).(*schema.Set)
+	if !diff.HasChange(tags) && noncompliant.Len() == 0 {
+		return nil
+	}
+	// The update searches the resources again and attaches the tags to all of
+	// the resources that miss them.
+	if err := diff.SetNewComputed(matchingResources); err != nil {
+		return err
+	}
+	return diff.SetNew(noncompliantResources, []interface{}{})
+}
+
+func resourceIBMTagPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	tType := d.Get(tagType).(string)
+	q := d.Get(query).(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", tType, q))
+
+	return resourceIBMTagPolicyEnforce(d, meta, nil)
+}
+
+func resourceIBMTagPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	tType, q, err := tagPolicyIdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceTags, err := searchResourceTags(meta, q, tType)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error searching the resources of tag policy (%s): %s", d.Id(), err)
+	}
+
+	policyTags := flex.ExpandStringList(d.Get(tags).(*schema.Set).List())
+	crns := make([]string, 0, len(resourceTags))
+	noncompliant := []string{}
+	for crn, attached := range resourceTags {
+		crns = append(crns, crn)
+		if len(missingTags(policyTags, attached)) > 0 {
+			noncompliant = append(noncompliant, crn)
+		}
+	}
+
+	d.Set(query, q)
+	d.Set(tagType, tType)
+	d.Set(matchingResources, crns)
+	d.Set(noncompliantResources, noncompliant)
+
+	return nil
+}
+
+func resourceIBMTagPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	var removed []string
+	if d.HasChange(tags) {
+		o, n := d.GetChange(tags)
+		removed = flex.ExpandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+	}
+
+	return resourceIBMTagPolicyEnforce(d, meta, removed)
+}
+
+// resourceIBMTagPolicyEnforce attaches the tags to every resource that matches
+// the query and misses any of them, and detaches the removed tags from the
+// resources the policy attached them to.
+func resourceIBMTagPolicyEnforce(d *schema.ResourceData, meta interface{}, removed []string) error {
+	tType, q, err := tagPolicyIdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceTags, err := searchResourceTags(meta, q, tType)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error searching the resources of tag policy (%s): %s", d.Id(), err)
+	}
+
+	tagged := expandTaggedResources(d.Get(taggedResources).(map[string]interface{}))
+	if len(removed) > 0 {
+		err = detachTaggedResources(meta, tagged, removed, tType)
+		d.Set(taggedResources, flattenTaggedResources(tagged))
+		if err != nil {
+			return err
+		}
+	}
+
+	policyTags := flex.ExpandStringList(d.Get(tags).(*schema.Set).List())
+	crns := make([]string, 0, len(resourceTags))
+	// The resources are grouped by the tags they miss, so that only the tags
+	// that the policy actually attaches are recorded.
+	toAttach := map[string][]string{}
+	for crn, attached := range resourceTags {
+		crns = append(crns, crn)
+		if missing := missingTags(policyTags, attached); len(missing) > 0 {
+			key := strings.Join(missing, ",")
+			toAttach[key] = append(toAttach[key], crn)
+		}
+	}
+
+	noncompliant := []string{}
+	for key, attachCRNs := range toAttach {
+		missing := strings.Split(key, ",")
+		failed, err := changeResourcesTags(meta, attachCRNs, missing, tType, true)
+		if err != nil {
+			d.Set(taggedResources, flattenTaggedResources(tagged))
+			return err
+		}
+		failedCRNs := make(map[string]bool, len(failed))
+		for _, crn := range failed {
+			failedCRNs[crn] = true
+		}
+		for _, crn := range attachCRNs {
+			if !failedCRNs[crn] {
+				tagged[crn] = append(tagged[crn], missing...)
+			}
+		}
+		noncompliant = append(noncompliant, failed...)
+	}
+
+	// The search index is updated asynchronously, so the state reflects the
+	// attach results instead of searching the resources again.
+	d.Set(matchingResources, crns)
+	d.Set(noncompliantResources, noncompliant)
+	d.Set(taggedResources, flattenTaggedResources(tagged))
+	if len(noncompliant) > 0 {
+		return fmt.Errorf("[ERROR] Error attaching tags %v to resources %v", policyTags, noncompliant)
+	}
+
+	return nil
+}
+
+// resourceIBMTagPolicyDelete only detaches the tags that the policy attached,
+// tags that were already attached to the resources are kept.
+func resourceIBMTagPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	tType, _, err := tagPolicyIdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tagged := expandTaggedResources(d.Get(taggedResources).(map[string]interface{}))
+	policyTags := flex.ExpandStringList(d.Get(tags).(*schema.Set).List())
+	if err = detachTaggedResources(meta, tagged, policyTags, tType); err != nil {
+		d.Set(taggedResources, flattenTaggedResources(tagged))
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// detachTaggedResources detaches the tags from the resources the policy
+// attached them to, and removes them from tagged.
+func detachTaggedResources(meta interface{}, tagged map[string][]string, tagNames []string, tType string) error {
+	for _, tag := range tagNames {
+		crns := []string{}
+		for crn, attached := range tagged {
+			if len(missingTags([]string{tag}, attached)) == 0 {
+				crns = append(crns, crn)
+			}
+		}
+		if len(crns) == 0 {
+			continue
+		}
+
+		failed, err := changeResourcesTags(meta, crns, []string{tag}, tType, false)
+		if err != nil {
+			return err
+		}
+		failedCRNs := make(map[string]bool, len(failed))
+		for _, crn := range failed {
+			failedCRNs[crn] = true
+		}
+		for _, crn := range crns {
+			if failedCRNs[crn] {
+				continue
+			}
+			remaining := missingTags(tagged[crn], []string{tag})
+			if len(remaining) == 0 {
+				delete(tagged, crn)
+			} else {
+				tagged[crn] = remaining
+			}
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("[ERROR] Error detaching tag %s from resources %v", tag, failed)
+		}
+	}
+	return nil
+}
+
+func expandTaggedResources(m map[string]interface{}) map[string][]string {
+	tagged := make(map[string][]string, len(m))
+	for crn, v := range m {
+		if t := v.(string); t != "" {
+			tagged[crn] = strings.Split(t, ",")
+		}
+	}
+	return tagged
+}
+
+func flattenTaggedResources(tagged map[string][]string) map[string]interface{} {
+	m := make(map[string]interface{}, len(tagged))
+	for crn, t := range tagged {
+		sorted := append([]string{}, t...)
+		sort.Strings(sorted)
+		m[crn] = strings.Join(sorted, ",")
+	}
+	return m
+}
+
+func tagPolicyIdParts(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of tagType/query", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// missingTags returns the tags that are not in attached.
+func missingTags(tags []string, attached []string) []string {
+	attachedTags := make(map[string]bool, len(attached))
+	for _, t := range attached {
+		attachedTags[t] = true
+	}
+	missing := []string{}
+	for _, t := range tags {
+		if !attachedTags[t] {
+			missing = append(missing, t)
+		}
+	}
+	return missing
+}
+
+// tagSearchField returns the Global Search field that holds the tags of the
+// given type.
+func tagSearchField(tType string) string {
+	switch tType {
+	case "access":
+		return "access_tags"
+	case "service":
+		return "service_tags"
+	default:
+		return "tags"
+	}
+}
+
+// searchResourceTags returns the tags of the given type of every resource that
+// matches the Global Search query, by CRN.
+func searchResourceTags(meta interface{}, q, tType string) (map[string][]string, error) {
+	return searchResourceTagsWithLimit(meta, q, tType, 0)
+}
+
+// searchResourceTagsWithLimit is searchResourceTags that fails when more than
+// maxResources resources match the query, unless maxResources is 0.
+func searchResourceTagsWithLimit(meta interface{}, q, tType string, maxResources int) (map[string][]string, error) {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+
+	field := tagSearchField(tType)
+	options := globalsearchv2.SearchOptions{}
+	options.SetQuery(q)
+	options.SetFields([]string{"crn", field})
+	options.SetLimit(searchPageSize)
+	if tType == service {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return nil, err
+		}
+		options.SetAccountID(userDetails.UserAccount)
+	}
+
+	resourceTags := map[string][]string{}
+	for {
+		result, resp, err := gsClient.Search(&options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error to query the resources: %s %s", err, resp)
+		}
+		for _, item := range result.Items {
+			if item.CRN == nil {
+				continue
+			}
+			attached := []string{}
+			if t, ok := item.GetProperty(field).([]interface{}); ok {
+				for _, tag := range t {
+					attached = append(attached, fmt.Sprint(tag))
+				}
+			}
+			resourceTags[*item.CRN] = attached
+		}
+		if maxResources > 0 && len(resourceTags) > maxResources {
+			return nil, fmt.Errorf("[ERROR] More than %d resources match the query %q, narrow the query", maxResources, q)
+		}
+		if len(result.Items) < searchPageSize || result.SearchCursor == nil {
+			break
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+	return resourceTags, nil
+}
+
+// changeResourcesTags attaches or detaches the tags in batches and returns the
+// resources the tags could not be attached to or detached from.
+func changeResourcesTags(meta interface{}, crns []string, tagNames []string, tType string, attach bool) ([]string, error) {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	sort.Strings(crns)
+	failed := []string{}
+	for start := 0; start < len(crns); start += tagBatchSize {
+		end := start + tagBatchSize
+		if end > len(crns) {
+			end = len(crns)
+		}
+		resources := make([]globaltaggingv1.Resource, 0, end-start)
+		for _, crn := range crns[start:end] {
+			resources = append(resources, globaltaggingv1.Resource{ResourceID: flex.PtrToString(crn)})
+		}
+
+		var results *globaltaggingv1.TagResults
+		if attach {
+			attachTagOptions := &globaltaggingv1.AttachTagOptions{
+				Resources: resources,
+				TagNames:  tagNames,
+				TagType:   flex.PtrToString(tType),
+			}
+			result, resp, err := gtClient.AttachTag(attachTagOptions)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error attaching resource tags : %v\n%s", resp, err)
+			}
+			results = result
+		} else {
+			detachTagOptions := &globaltaggingv1.DetachTagOptions{
+				Resources: resources,
+				TagNames:  tagNames,
+				TagType:   flex.PtrToString(tType),
+			}
+			result, resp, err := gtClient.DetachTag(detachTagOptions)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error detaching resource tags %v: %s\n%s", tagNames, err, resp)
+			}
+			results = result
+		}
+
+		for _, result := range results.Results {
+			if result.IsError != nil && *result.IsError && result.ResourceID != nil {
+				failed = append(failed, *result.ResourceID)
+			}
+		}
+	}
+	return failed, nil
+}
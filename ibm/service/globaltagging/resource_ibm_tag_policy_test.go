@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTagPolicy_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-tag-policy-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTagPolicyConfig(name, `["cost-center:1234", "env:dev"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tag_policy.policy", "tags.#", "2"),
+					resource.TestCheckResourceAttr("ibm_tag_policy.policy", "resources.#", "1"),
+					resource.TestCheckResourceAttr("ibm_tag_policy.policy", "noncompliant_resources.#", "0"),
+					resource.TestCheckResourceAttr("ibm_tag_policy.policy", "tagged_resources.%", "1"),
+				),
+			},
+			{
+				Config: testAccCheckTagPolicyConfig(name, `["cost-center:5678"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tag_policy.policy", "tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_tag_policy.policy", "noncompliant_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagPolicyConfig(name, tags string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "lite"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_tag_policy" "policy" {
+		query = "crn:\"${ibm_resource_instance.instance.crn}\""
+		tags  = %s
+	}
+`, name, tags)
+}
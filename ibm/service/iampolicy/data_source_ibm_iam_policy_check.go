@@ -0,0 +1,498 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Data source to find the access policies that already grant a subject access to a resource
+func DataSourceIBMIAMPolicyCheck() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMPolicyCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"iam_id", "iam_service_id", "profile_id", "access_group_id"},
+				Description:  "IAM ID of the user, service ID or trusted profile to check",
+			},
+			"iam_service_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"iam_id", "iam_service_id", "profile_id", "access_group_id"},
+				Description:  "UUID of the service ID to check",
+			},
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"iam_id", "iam_service_id", "profile_id", "access_group_id"},
+				Description:  "UUID of the trusted profile to check",
+			},
+			"access_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"iam_id", "iam_service_id", "profile_id", "access_group_id"},
+				Description:  "ID of the access group to check",
+			},
+			"include_access_groups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Also check the policies of the access groups the subject is a member of",
+			},
+			"resource_crn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"resource_crn", "resource_attributes"},
+				Description:  "CRN of the resource to check access to",
+			},
+			"resource_attributes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"resource_crn", "resource_attributes"},
+				Description:  "Attributes of the resource to check access to, added to or overriding the attributes of resource_crn",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+					},
+				},
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report policies with a role that grants this action, for example cloud-object-storage.object.get",
+			},
+			"transaction_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Set transactionID for debug",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one policy grants the subject access to the resource",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names granted by the matching policies",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies that grant the subject access to the resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subject attribute of the policy, either iam_id or access_group_id",
+						},
+						"subject_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAM ID or access group ID the policy is assigned to",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Resource attributes of the policy definition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+						"conditional": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the policy only grants access when its rule conditions are met",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the Policy",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// policyCheckSubject is a subject whose policies are evaluated
+type policyCheckSubject struct {
+	subjectType string
+	subjectID   string
+}
+
+func dataSourceIBMIAMPolicyCheckRead(d *schema.ResourceData, meta interface{}) error {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	accountID := userDetails.UserAccount
+
+	headers := map[string]string{}
+	if transactionID, ok := d.GetOk("transaction_id"); ok {
+		headers["Transaction-Id"] = transactionID.(string)
+	}
+
+	subjects, err := policyCheckSubjects(d, meta, accountID, headers)
+	if err != nil {
+		return err
+	}
+
+	target := PolicyCheckTarget(d)
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	var roleActions map[string][]string
+	action := d.Get("action").(string)
+	if action != "" {
+		roleActions, err = policyCheckRoleActions(iamPolicyManagementClient, accountID, target["serviceName"], headers)
+		if err != nil {
+			return err
+		}
+	}
+
+	matchedPolicies := make([]map[string]interface{}, 0)
+	matchedRoles := make(map[string]bool)
+	for _, subject := range subjects {
+		listPoliciesOptions := &iampolicymanagementv1.V2ListPoliciesOptions{
+			AccountID: core.StringPtr(accountID),
+			Type:      core.StringPtr("access"),
+			State:     core.StringPtr("active"),
+			Headers:   headers,
+		}
+		if subject.subjectType == "access_group_id" {
+			listPoliciesOptions.AccessGroupID = core.StringPtr(subject.subjectID)
+		} else {
+			listPoliciesOptions.IamID = core.StringPtr(subject.subjectID)
+		}
+
+		policyList, resp, err := iamPolicyManagementClient.V2ListPolicies(listPoliciesOptions)
+		if err != nil || policyList == nil {
+			return fmt.Errorf("[ERROR] Error listing policies of %s %s: %s\n%s", subject.subjectType, subject.subjectID, err, resp)
+		}
+
+		for i := range policyList.Policies {
+			v2Policy := policyList.Policies[i]
+			policy := flex.ConvertV2PolicyToPolicy(&v2Policy)
+			if len(policy.Resources) == 0 || !PolicyCheckResourceMatches(policy.Resources[0], target) {
+				continue
+			}
+
+			roles := make([]string, 0, len(policy.Roles))
+			granted := action == ""
+			for _, role := range policy.Roles {
+				roles = append(roles, policyCheckRoleName(role))
+				if !granted && role.RoleID != nil {
+					for _, a := range roleActions[*role.RoleID] {
+						if a == action {
+							granted = true
+							break
+						}
+					}
+				}
+			}
+			if !granted {
+				continue
+			}
+			for _, role := range roles {
+				matchedRoles[role] = true
+			}
+
+			p := map[string]interface{}{
+				"id":                  policy.ID,
+				"subject_type":        subject.subjectType,
+				"subject_id":          subject.subjectID,
+				"roles":               roles,
+				"resource_attributes": flex.FlattenPolicyResourceAttributes(policy.Resources),
+				"conditional":         v2Policy.Rule != nil,
+			}
+			if policy.Description != nil {
+				p["description"] = *policy.Description
+			}
+			matchedPolicies = append(matchedPolicies, p)
+		}
+	}
+
+	roles := make([]string, 0, len(matchedRoles))
+	for role := range matchedRoles {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	d.SetId(fmt.Sprintf("%s/%s", subjects[0].subjectID, policyCheckTargetID(target)))
+	d.Set("allowed", len(matchedPolicies) > 0)
+	d.Set("roles", roles)
+	if err := d.Set("policies", matchedPolicies); err != nil {
+		return fmt.Errorf("[ERROR] Error setting policies: %s", err)
+	}
+	return nil
+}
+
+// policyCheckSubjects resolves the configured subject to an IAM ID or access group and, for
+// IAM IDs, adds the access groups the subject is a member of.
+func policyCheckSubjects(d *schema.ResourceData, meta interface{}, accountID string, headers map[string]string) ([]policyCheckSubject, error) {
+	if v, ok := d.GetOk("access_group_id"); ok {
+		return []policyCheckSubject{{subjectType: "access_group_id", subjectID: v.(string)}}, nil
+	}
+
+	var iamID string
+	if v, ok := d.GetOk("iam_id"); ok {
+		iamID = v.(string)
+	}
+	if v, ok := d.GetOk("iam_service_id"); ok {
+		iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return nil, err
+		}
+		getServiceIDOptions := &iamidentityv1.GetServiceIDOptions{
+			ID:      core.StringPtr(v.(string)),
+			Headers: headers,
+		}
+		serviceID, resp, err := iamClient.GetServiceID(getServiceIDOptions)
+		if err != nil || serviceID == nil {
+			return nil, fmt.Errorf("[ERROR] Error getting service ID %s: %s\n%s", v.(string), err, resp)
+		}
+		iamID = *serviceID.IamID
+	}
+	if v, ok := d.GetOk("profile_id"); ok {
+		iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return nil, err
+		}
+		getProfileOptions := &iamidentityv1.GetProfileOptions{
+			ProfileID: core.StringPtr(v.(string)),
+			Headers:   headers,
+		}
+		profile, resp, err := iamClient.GetProfile(getProfileOptions)
+		if err != nil || profile == nil {
+			return nil, fmt.Errorf("[ERROR] Error getting trusted profile %s: %s\n%s", v.(string), err, resp)
+		}
+		iamID = *profile.IamID
+	}
+
+	subjects := []policyCheckSubject{{subjectType: "iam_id", subjectID: iamID}}
+	if !d.Get("include_access_groups").(bool) {
+		return subjects, nil
+	}
+
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+	limit := int64(100)
+	offset := int64(0)
+	listAccessGroupsOptions := &iamaccessgroupsv2.ListAccessGroupsOptions{
+		AccountID: core.StringPtr(accountID),
+		IamID:     core.StringPtr(iamID),
+		Limit:     &limit,
+		Offset:    &offset,
+		Headers:   headers,
+	}
+	for {
+		groups, resp, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupsOptions)
+		if err != nil || groups == nil {
+			return nil, fmt.Errorf("[ERROR] Error listing access groups of %s: %s\n%s", iamID, err, resp)
+		}
+		for _, group := range groups.Groups {
+			subjects = append(subjects, policyCheckSubject{subjectType: "access_group_id", subjectID: *group.ID})
+		}
+		offset = offset + limit
+		if len(groups.Groups) == 0 || offset >= *groups.TotalCount {
+			break
+		}
+		listAccessGroupsOptions.SetOffset(offset)
+	}
+	return subjects, nil
+}
+
+// PolicyCheckTarget builds the resource attributes to check from resource_crn and
+// resource_attributes.
+func PolicyCheckTarget(d *schema.ResourceData) map[string]string {
+	target := make(map[string]string)
+	if v, ok := d.GetOk("resource_crn"); ok {
+		// crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+		crnParts := strings.Split(v.(string), ":")
+		crnAttributes := map[int]string{
+			4: "serviceName",
+			5: "region",
+			7: "serviceInstance",
+			8: "resourceType",
+			9: "resource",
+		}
+		for i, name := range crnAttributes {
+			if i < len(crnParts) && crnParts[i] != "" && crnParts[i] != "global" {
+				target[name] = crnParts[i]
+			}
+		}
+		if len(crnParts) > 6 && strings.HasPrefix(crnParts[6], "a/") {
+			target["accountId"] = strings.TrimPrefix(crnParts[6], "a/")
+		}
+	}
+	if v, ok := d.GetOk("resource_attributes"); ok {
+		for _, attribute := range v.(*schema.Set).List() {
+			a := attribute.(map[string]interface{})
+			target[a["name"].(string)] = a["value"].(string)
+		}
+	}
+	return target
+}
+
+func policyCheckTargetID(target map[string]string) string {
+	names := make([]string, 0, len(target))
+	for name := range target {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s=%s", name, target[name]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// PolicyCheckResourceMatches reports whether every attribute of the policy resource is
+// satisfied by the target. An attribute that the target does not carry is not satisfied,
+// so a policy scoped to a resource group only matches when resourceGroupId is given.
+func PolicyCheckResourceMatches(resource iampolicymanagementv1.PolicyResource, target map[string]string) bool {
+	for _, a := range resource.Attributes {
+		if a.Name == nil || a.Value == nil {
+			continue
+		}
+		name, value := *a.Name, *a.Value
+		operator := "stringEquals"
+		if a.Operator != nil && *a.Operator != "" {
+			operator = *a.Operator
+		}
+		targetValue, ok := target[name]
+
+		switch {
+		case name == "accountId" && !ok:
+			// policies are listed for the current account only
+		case name == "serviceType" && !ok:
+			// serviceType=service covers every IAM enabled service, account management
+			// services have to be checked with an explicit serviceType attribute
+			if value != "service" || target["serviceName"] == "" {
+				return false
+			}
+		case operator == "stringExists":
+			if (value == "true") != ok {
+				return false
+			}
+		case operator == "stringMatch":
+			if !ok || !PolicyCheckWildcardMatch(value, targetValue) {
+				return false
+			}
+		default:
+			if !ok || targetValue != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// PolicyCheckWildcardMatch matches value against an IAM pattern where * matches any
+// sequence of characters and ? a single character.
+func PolicyCheckWildcardMatch(pattern, value string) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	matched, err := regexp.MatchString("^"+expr+"$", value)
+	return err == nil && matched
+}
+
+// policyCheckRoleActions returns the actions granted by each role CRN for the service.
+func policyCheckRoleActions(client *iampolicymanagementv1.IamPolicyManagementV1, accountID, serviceName string, headers map[string]string) (map[string][]string, error) {
+	listRolesOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID: core.StringPtr(accountID),
+		Headers:   headers,
+	}
+	if serviceName != "" {
+		listRolesOptions.ServiceName = core.StringPtr(serviceName)
+	}
+	roleList, resp, err := client.ListRoles(listRolesOptions)
+	if err != nil || roleList == nil {
+		return nil, fmt.Errorf("[ERROR] Error listing roles of %s: %s\n%s", serviceName, err, resp)
+	}
+	roleActions := make(map[string][]string)
+	for _, role := range roleList.SystemRoles {
+		roleActions[*role.CRN] = role.Actions
+	}
+	for _, role := range roleList.ServiceRoles {
+		roleActions[*role.CRN] = role.Actions
+	}
+	for _, role := range roleList.CustomRoles {
+		roleActions[*role.CRN] = role.Actions
+	}
+	return roleActions, nil
+}
+
+// policyCheckRoleName returns the display name of a policy role, falling back to the last
+// segment of its CRN, for example Viewer for crn:v1:bluemix:public:iam::::role:Viewer.
+func policyCheckRoleName(role iampolicymanagementv1.PolicyRole) string {
+	if role.DisplayName != nil && *role.DisplayName != "" {
+		return *role.DisplayName
+	}
+	if role.RoleID == nil {
+		return ""
+	}
+	parts := strings.Split(*role.RoleID, ":")
+	return parts[len(parts)-1]
+}
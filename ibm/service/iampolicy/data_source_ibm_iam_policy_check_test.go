@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iampolicy"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMIAMPolicyCheckDataSource_ServiceID(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyCheckDataSourceServiceID(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_check.allowed", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_check.allowed", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_check.allowed", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_check.denied", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_check.denied", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMPolicyCheckDataSource_AccessGroup(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyCheckDataSourceAccessGroup(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_check.check", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_check.check", "policies.0.subject_type", "access_group_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyCheckDataSourceServiceID(name string) string {
+	return fmt.Sprintf(`
+resource "ibm_iam_service_id" "serviceID" {
+  name = "%s"
+}
+
+resource "ibm_iam_service_policy" "policy" {
+  iam_service_id = ibm_iam_service_id.serviceID.id
+  roles          = ["Reader"]
+  resource_attributes {
+    name  = "serviceName"
+    value = "cloud-object-storage"
+  }
+  resource_attributes {
+    name     = "resource"
+    value    = "bucket-*"
+    operator = "stringMatch"
+  }
+}
+
+data "ibm_iam_policy_check" "allowed" {
+  iam_service_id = ibm_iam_service_policy.policy.iam_service_id
+  resource_attributes {
+    name  = "serviceName"
+    value = "cloud-object-storage"
+  }
+  resource_attributes {
+    name  = "resource"
+    value = "bucket-logs"
+  }
+  action = "cloud-object-storage.object.get"
+}
+
+data "ibm_iam_policy_check" "denied" {
+  iam_service_id = ibm_iam_service_policy.policy.iam_service_id
+  resource_attributes {
+    name  = "serviceName"
+    value = "cloud-object-storage"
+  }
+  resource_attributes {
+    name  = "resource"
+    value = "archive"
+  }
+}
+`, name)
+}
+
+func testAccCheckIBMIAMPolicyCheckDataSourceAccessGroup(name string) string {
+	return fmt.Sprintf(`
+resource "ibm_iam_access_group" "accgrp" {
+  name = "%s"
+}
+
+resource "ibm_iam_access_group_policy" "policy" {
+  access_group_id = ibm_iam_access_group.accgrp.id
+  roles           = ["Viewer"]
+  resources {
+    service = "kms"
+  }
+}
+
+data "ibm_iam_policy_check" "check" {
+  access_group_id = ibm_iam_access_group_policy.policy.access_group_id
+  resource_crn    = "crn:v1:bluemix:public:kms:us-south::::"
+}
+`, name)
+}
+
+func TestPolicyCheckWildcardMatch(t *testing.T) {
+	testcases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"bucket-*", "bucket-logs", true},
+		{"bucket-*", "bucket-", true},
+		{"bucket-*", "my-bucket-logs", false},
+		{"log?", "logs", true},
+		{"log?", "log", false},
+		{"a.b", "axb", false},
+		{"key(1)+", "key(1)+", true},
+	}
+
+	for _, c := range testcases {
+		assert.Equal(t, c.expected, iampolicy.PolicyCheckWildcardMatch(c.pattern, c.value), c.pattern+" "+c.value)
+	}
+}
+
+func TestPolicyCheckResourceMatches(t *testing.T) {
+	target := map[string]string{
+		"serviceName":     "cloud-object-storage",
+		"serviceInstance": "instance-1",
+		"resourceType":    "bucket",
+		"resource":        "bucket-logs",
+	}
+	testcases := []struct {
+		attributes []iampolicymanagementv1.ResourceAttribute
+		expected   bool
+	}{
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("serviceName"), Value: core.StringPtr("cloud-object-storage")},
+				{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr("instance-1"), Operator: core.StringPtr("stringEquals")},
+			},
+			expected: true,
+		},
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("serviceName"), Value: core.StringPtr("kms")},
+			},
+			expected: false,
+		},
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("resource"), Value: core.StringPtr("bucket-*"), Operator: core.StringPtr("stringMatch")},
+			},
+			expected: true,
+		},
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("resourceType"), Value: core.StringPtr("false"), Operator: core.StringPtr("stringExists")},
+			},
+			expected: false,
+		},
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("prefix"), Value: core.StringPtr("false"), Operator: core.StringPtr("stringExists")},
+			},
+			expected: true,
+		},
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("resourceGroupId"), Value: core.StringPtr("group-1")},
+			},
+			expected: false,
+		},
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("accountId"), Value: core.StringPtr("account-1")},
+				{Name: core.StringPtr("serviceType"), Value: core.StringPtr("service")},
+			},
+			expected: true,
+		},
+		{
+			attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("serviceType"), Value: core.StringPtr("platform_service")},
+			},
+			expected: false,
+		},
+	}
+
+	for _, c := range testcases {
+		resource := iampolicymanagementv1.PolicyResource{Attributes: c.attributes}
+		assert.Equal(t, c.expected, iampolicy.PolicyCheckResourceMatches(resource, target))
+	}
+}
+
+func TestPolicyCheckTarget(t *testing.T) {
+	testcases := []struct {
+		raw      map[string]interface{}
+		expected map[string]string
+	}{
+		{
+			raw: map[string]interface{}{
+				"resource_crn": "crn:v1:bluemix:public:cloud-object-storage:global:a/account-1:instance-1:bucket:bucket-logs",
+			},
+			expected: map[string]string{
+				"serviceName":     "cloud-object-storage",
+				"serviceInstance": "instance-1",
+				"resourceType":    "bucket",
+				"resource":        "bucket-logs",
+				"accountId":       "account-1",
+			},
+		},
+		{
+			raw: map[string]interface{}{
+				"resource_crn": "crn:v1:bluemix:public:kms:us-south:a/account-1:instance-1::",
+				"resource_attributes": []interface{}{
+					map[string]interface{}{"name": "region", "value": "eu-de"},
+					map[string]interface{}{"name": "resourceGroupId", "value": "group-1"},
+				},
+			},
+			expected: map[string]string{
+				"serviceName":     "kms",
+				"region":          "eu-de",
+				"serviceInstance": "instance-1",
+				"accountId":       "account-1",
+				"resourceGroupId": "group-1",
+			},
+		},
+	}
+
+	for _, c := range testcases {
+		d := schema.TestResourceDataRaw(t, iampolicy.DataSourceIBMIAMPolicyCheck().Schema, c.raw)
+		assert.DeepEqual(t, c.expected, iampolicy.PolicyCheckTarget(d))
+	}
+}
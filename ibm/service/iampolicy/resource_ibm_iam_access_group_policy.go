@@ -178,6 +178,12 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				},
 			},
 
+			"rule_conditions": flex.PolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.PolicyRuleOperatorSchema(),
+
+			"pattern": flex.PolicyPatternSchema(),
+
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -269,7 +275,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	accessGroupPolicy, res, err := flex.CreatePolicy(iamPolicyManagementClient, d, createPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
 	}
@@ -284,7 +290,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -295,7 +301,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
@@ -332,7 +338,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || accessGroupPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -343,7 +349,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || accessGroupPolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
@@ -386,6 +392,10 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		d.Set("description", *accessGroupPolicy.Description)
 	}
 
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, accessGroupPolicyId, getPolicyOptions.Headers); err != nil {
+		return err
+	}
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -399,7 +409,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -455,7 +465,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, res, err := flex.UpdatePolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
 		}
@@ -514,7 +524,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		accessGroupPolicyId,
 	)
 
-	accessGroupPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, resp, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -543,11 +553,15 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 	}
 	accgrpPolicyID := parts[1]
 
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, accgrpPolicyID, nil); err != nil {
+		return nil, nil, err
+	}
+
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		accgrpPolicyID,
 	)
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, res, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
 	}
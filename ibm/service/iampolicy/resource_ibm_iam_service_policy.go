@@ -187,6 +187,12 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				},
 			},
 
+			"rule_conditions": flex.PolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.PolicyRuleOperatorSchema(),
+
+			"pattern": flex.PolicyPatternSchema(),
+
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -292,7 +298,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	servicePolicy, res, err := flex.CreatePolicy(iamPolicyManagementClient, d, createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
 	}
@@ -307,7 +313,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -319,7 +325,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -367,7 +373,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || servicePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -379,7 +385,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || servicePolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
@@ -419,6 +425,10 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("description", *servicePolicy.Description)
 	}
 
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, servicePolicyID, getPolicyOptions.Headers); err != nil {
+		return err
+	}
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -428,7 +438,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -504,7 +514,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -530,7 +540,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 		if transactionID, ok := d.GetOk("transaction_id"); ok {
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
-		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, _, err = flex.UpdatePolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating service policy: %s", err)
 		}
@@ -590,7 +600,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 		servicePolicyID,
 	)
 
-	servicePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, resp, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || servicePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -618,10 +628,14 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 		return nil, nil, err
 	}
 	servicePolicyID := parts[1]
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, servicePolicyID, nil); err != nil {
+		return nil, nil, err
+	}
+
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		servicePolicyID,
 	)
-	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, _, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s", err)
 	}
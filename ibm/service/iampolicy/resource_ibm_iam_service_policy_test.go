@@ -332,6 +332,29 @@ func TestAccIBMIAMServicePolicy_With_Transaction_Id(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServicePolicy_With_Time_Based_Conditions(t *testing.T) {
+	var conf iampolicymanagementv1.Policy
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServicePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServicePolicyTimeBasedConditions(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServicePolicyExists("ibm_iam_service_policy.policy", conf),
+					resource.TestCheckResourceAttr("ibm_iam_service_id.serviceID", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "rule_conditions.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "pattern", "time-based-conditions:once"),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "resource_attributes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMServicePolicyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -750,3 +773,36 @@ func testAccCheckIBMIAMServicePolicyResourceTransactionIdUpdate(name string) str
 	  }
 	`, name)
 }
+
+func testAccCheckIBMIAMServicePolicyTimeBasedConditions(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_service_policy" "policy" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			roles          = ["Viewer"]
+			resource_attributes {
+				name  = "serviceName"
+				value = "cloud-object-storage"
+			}
+			resource_attributes {
+				name     = "resource"
+				value    = "bucket-*"
+				operator = "stringMatch"
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_date_time}}"
+				operator = "dateTimeGreaterThanOrEquals"
+				value    = ["2023-01-01T09:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_date_time}}"
+				operator = "dateTimeLessThanOrEquals"
+				value    = ["2023-01-02T09:00:00+00:00"]
+			}
+			pattern = "time-based-conditions:once"
+		}
+	`, name)
+}
@@ -187,6 +187,12 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				},
 			},
 
+			"rule_conditions": flex.PolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.PolicyRuleOperatorSchema(),
+
+			"pattern": flex.PolicyPatternSchema(),
+
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -292,7 +298,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	trustedProfilePolicy, res, err := flex.CreatePolicy(iamPolicyManagementClient, d, createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res)
 	}
@@ -307,7 +313,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -319,7 +325,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("profile_id"); ok && v != nil {
@@ -366,7 +372,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || trustedProfilePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -378,7 +384,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || trustedProfilePolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
@@ -417,6 +423,10 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	if trustedProfilePolicy.Description != nil {
 		d.Set("description", *trustedProfilePolicy.Description)
 	}
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, trustedProfilePolicyID, getPolicyOptions.Headers); err != nil {
+		return err
+	}
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -426,7 +436,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 
 func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -497,7 +507,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -524,7 +534,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, resp, err := flex.UpdatePolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp)
 		}
@@ -584,7 +594,7 @@ func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta inter
 		trustedProfilePolicyID,
 	)
 
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	trustedProfilePolicy, resp, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || trustedProfilePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -612,10 +622,14 @@ func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (inter
 		return nil, nil, err
 	}
 	trustedProfilePolicyID := parts[1]
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, trustedProfilePolicyID, nil); err != nil {
+		return nil, nil, err
+	}
+
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		trustedProfilePolicyID,
 	)
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	trustedProfilePolicy, resp, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, resp)
 	}
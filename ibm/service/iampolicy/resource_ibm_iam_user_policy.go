@@ -174,6 +174,12 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				},
 			},
 
+			"rule_conditions": flex.PolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.PolicyRuleOperatorSchema(),
+
+			"pattern": flex.PolicyPatternSchema(),
+
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -253,7 +259,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	userPolicy, resp, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	userPolicy, resp, err := flex.CreatePolicy(iamPolicyManagementClient, d, createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
 	}
@@ -268,7 +274,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -280,7 +286,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
@@ -317,7 +323,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || userPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -329,7 +335,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, res, err = flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || userPolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
@@ -363,6 +369,10 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if userPolicy.Description != nil {
 		d.Set("description", *userPolicy.Description)
 	}
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, userPolicyID, getPolicyOptions.Headers); err != nil {
+		return err
+	}
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -375,7 +385,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -427,7 +437,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -454,7 +464,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, resp, err := flex.UpdatePolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
 		}
@@ -511,7 +521,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 		userPolicyID,
 	)
 
-	userPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, resp, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || userPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -540,10 +550,14 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	}
 	userPolicyID := parts[1]
 
+	if err := flex.SetV2PolicyRule(d, iamPolicyManagementClient, userPolicyID, nil); err != nil {
+		return nil, nil, err
+	}
+
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		userPolicyID,
 	)
-	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, _, err := flex.GetPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving User Policy: %s", err)
 	}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"strings"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPIWorkspace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIWorkspaceRead,
+		Schema: map[string]*schema.Schema{
+
+			// Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID of the workspace",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_WorkspaceCapabilities: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Capabilities of the workspace",
+			},
+			Attr_WorkspaceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the workspace",
+			},
+			Attr_WorkspaceDatacenter: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Datacenter of the workspace",
+			},
+			Attr_WorkspaceName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the workspace",
+			},
+			Attr_WorkspacePlanID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the service plan of the workspace",
+			},
+			Attr_WorkspaceResourceGroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the resource group of the workspace",
+			},
+			Attr_WorkspaceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the workspace",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instance, _, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &cloudInstanceID,
+	})
+	if err != nil {
+		return diag.Errorf("error retrieving workspace %s: %v", cloudInstanceID, err)
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := st.NewIBMPICloudInstanceClient(ctx, sess, cloudInstanceID)
+	cloudInstance, err := client.Get(cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*instance.GUID)
+	d.Set(Attr_WorkspaceCapabilities, cloudInstance.Capabilities)
+	d.Set(Attr_WorkspaceCRN, instance.CRN)
+	d.Set(Attr_WorkspaceName, instance.Name)
+	d.Set(Attr_WorkspacePlanID, instance.ResourcePlanID)
+	d.Set(Attr_WorkspaceResourceGroupID, instance.ResourceGroupID)
+	d.Set(Attr_WorkspaceStatus, instance.State)
+	if instance.CRN != nil {
+		crn := strings.Split(*instance.CRN, ":")
+		if len(crn) > 5 {
+			d.Set(Attr_WorkspaceDatacenter, crn[5])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIWorkspaceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace.testacc_ds_workspace", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace.testacc_ds_workspace", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_pi_workspace" "testacc_ds_workspace" {
+		pi_cloud_instance_id = "%s"
+	}`, acc.Pi_cloud_instance_id)
+}
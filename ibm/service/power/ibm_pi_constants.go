@@ -68,6 +68,10 @@ const (
 	Arg_PIInstanceSharedProcessorPool    = "pi_shared_processor_pool"
 	Attr_PIInstanceSharedProcessorPool   = "shared_processor_pool"
 	Attr_PIInstanceSharedProcessorPoolID = "shared_processor_pool_id"
+	Attr_PIInstanceInstances             = "instances"
+	Attr_PIInstanceInstanceID            = "instance_id"
+	Attr_PIInstanceInstanceName          = "name"
+	Attr_PIInstanceInstanceStatus        = "status"
 
 	// Placement Group
 	PIPlacementGroupID      = "placement_group_id"
@@ -138,6 +142,25 @@ const (
 	Attr_SPPPlacementGroupPolicy  = "policy"
 	Attr_SPPPlacementGroupName    = "name"
 
+	// Workspace
+	Arg_WorkspaceName            = "pi_name"
+	Arg_WorkspaceDatacenter      = "pi_datacenter"
+	Arg_WorkspaceResourceGroupID = "pi_resource_group_id"
+	Arg_WorkspacePlan            = "pi_plan"
+
+	Attr_WorkspaceCapabilities    = "capabilities"
+	Attr_WorkspaceCRN             = "crn"
+	Attr_WorkspaceDatacenter      = "datacenter"
+	Attr_WorkspaceName            = "name"
+	Attr_WorkspacePlanID          = "plan_id"
+	Attr_WorkspaceResourceGroupID = "resource_group_id"
+	Attr_WorkspaceStatus          = "status"
+
+	WorkspaceServiceName  = "power-iaas"
+	WorkspaceDefaultPlan  = "power-virtual-server-group"
+	WorkspaceStatusActive = "active"
+	WorkspaceStatusFailed = "failed"
+
 	// status
 	// common status states
 	StatusShutoff = "SHUTOFF"
@@ -16,7 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/errors"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -30,6 +33,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 		UpdateContext: resourceIBMPIInstanceUpdate,
 		DeleteContext: resourceIBMPIInstanceDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMPIInstanceReplicantsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -287,6 +291,30 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Default:     1,
 				Description: "PI Instance replicas count",
 			},
+			Attr_PIInstanceInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "PVM instances created for the PI Instance, one per replicant",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_PIInstanceInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "PVM instance ID",
+						},
+						Attr_PIInstanceInstanceName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "PVM instance name",
+						},
+						Attr_PIInstanceInstanceStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "PVM instance status",
+						},
+					},
+				},
+			},
 			helpers.PIInstanceReplicationPolicy: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -384,6 +412,15 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *(*pvmList)[0].PvmInstanceID))
 
+	// Record every replicant so that read, update and delete act on all of them
+	instances := make([]map[string]interface{}, 0, len(*pvmList))
+	for _, s := range *pvmList {
+		instances = append(instances, map[string]interface{}{
+			Attr_PIInstanceInstanceID: *s.PvmInstanceID,
+		})
+	}
+	d.Set(Attr_PIInstanceInstances, instances)
+
 	for _, s := range *pvmList {
 		_, err = isWaitForPIInstanceAvailable(ctx, client, *s.PvmInstanceID, instanceReadyStatus)
 		if err != nil {
@@ -496,16 +533,35 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set(helpers.PIInstanceLicenseRepositoryCapacity, powervmdata.LicenseRepositoryCapacity)
 	d.Set(PIInstanceDeploymentType, powervmdata.DeploymentType)
+
+	instances := make([]map[string]interface{}, 0)
+	for _, id := range piInstanceIDs(d, instanceID) {
+		pvm := powervmdata
+		if id != instanceID {
+			pvm, err = client.Get(id)
+			if err != nil {
+				uErr := errors.Unwrap(err)
+				if _, ok := uErr.(*p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound); ok {
+					log.Printf("[DEBUG] replicant %s does not exist anymore %v", id, err)
+					continue
+				}
+				return diag.FromErr(err)
+			}
+		}
+		instances = append(instances, flattenPIInstanceReplicant(id, pvm))
+	}
+	d.Set(Attr_PIInstanceInstances, instances)
+	// An imported instance is tracked on its own, whatever replicant group it was created in
+	if _, ok := d.GetOk(helpers.PIInstanceReplicants); !ok {
+		d.Set(helpers.PIInstanceReplicants, 1)
+	}
+
 	return nil
 }
 
 func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(helpers.PIInstanceName).(string)
-	mem := d.Get(helpers.PIInstanceMemory).(float64)
-	procs := d.Get(helpers.PIInstanceProcessors).(float64)
-	processortype := d.Get(helpers.PIInstanceProcType).(string)
-	assignedVirtualCores := int64(d.Get(helpers.PIVirtualCoresAssigned).(int))
 
 	if d.Get("health_status") == "WARNING" {
 		return diag.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
@@ -545,22 +601,54 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// Resource changes are applied to every replicant
+	for _, id := range piInstanceIDs(d, instanceID) {
+		err = updatePIInstanceResources(ctx, d, sess, client, cloudInstanceID, id, cores_enabled)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIInstanceRead(ctx, d, meta)
+
+}
+
+// resourceIBMPIInstanceReplicantsDiff rejects a change of pi_replicants on an existing
+// instance. Update applies changes to every replicant but does not add or remove them,
+// and replacing the instance would recreate every PVM instance of the set.
+func resourceIBMPIInstanceReplicantsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange(helpers.PIInstanceReplicants) {
+		o, n := diff.GetChange(helpers.PIInstanceReplicants)
+		return fmt.Errorf("%s cannot be changed from %d to %d on an existing instance, create a separate ibm_pi_instance for additional instances", helpers.PIInstanceReplicants, o.(int), n.(int))
+	}
+	return nil
+}
+
+// updatePIInstanceResources applies the processor, memory, profile, storage pool affinity
+// and placement group changes to a single PVM instance of the resource.
+func updatePIInstanceResources(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, client *st.IBMPIInstanceClient, cloudInstanceID, instanceID string, coresEnabled bool) error {
+	mem := d.Get(helpers.PIInstanceMemory).(float64)
+	procs := d.Get(helpers.PIInstanceProcessors).(float64)
+	processortype := d.Get(helpers.PIInstanceProcType).(string)
+	assignedVirtualCores := int64(d.Get(helpers.PIVirtualCoresAssigned).(int))
+	var err error
+
 	if d.HasChange(helpers.PIInstanceProcType) {
 
 		// Stop the lpar
-		if d.Get("status") == "SHUTOFF" {
+		if isPIInstanceShutoff(client, instanceID) {
 			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
 		} else {
 			err := stopLparForResourceChange(ctx, client, instanceID)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 
 		// Modify
 		log.Printf("At this point the lpar should be off. Executing the Processor Update Change")
 		updatebody := &models.PVMInstanceUpdate{ProcType: processortype}
-		if cores_enabled {
+		if coresEnabled {
 			log.Printf("support for %s is enabled", CUSTOM_VIRTUAL_CORES)
 			updatebody.VirtualCores = &models.VirtualCores{Assigned: &assignedVirtualCores}
 		} else {
@@ -568,17 +656,17 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = client.Update(instanceID, updatebody)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
 		// Start the lpar
 		err := startLparAfterResourceChange(ctx, client, instanceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
@@ -589,11 +677,11 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
+			return fmt.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
 		}
 		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
@@ -617,7 +705,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 
 		} else {
@@ -630,7 +718,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 				migratable := m.(bool)
 				body.Migratable = &migratable
 			}
-			if cores_enabled {
+			if coresEnabled {
 				log.Printf("support for %s is enabled", CUSTOM_VIRTUAL_CORES)
 				body.VirtualCores = &models.VirtualCores{Assigned: &assignedVirtualCores}
 			} else {
@@ -639,11 +727,11 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 			_, err = client.Update(instanceID, body)
 			if err != nil {
-				return diag.Errorf("failed to update the lpar with the change %v", err)
+				return fmt.Errorf("failed to update the lpar with the change %v", err)
 			}
 			_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 	}
@@ -658,22 +746,22 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
+			return fmt.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
 		}
 		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
 		if err != nil {
-			diag.FromErr(err)
+			return err
 		}
 	}
 
 	if d.HasChange(PISAPInstanceProfileID) {
 		// Stop the lpar
-		if d.Get("status") == "SHUTOFF" {
+		if isPIInstanceShutoff(client, instanceID) {
 			log.Printf("the lpar is in the shutoff state. Nothing to do... Moving on ")
 		} else {
 			err := stopLparForResourceChange(ctx, client, instanceID)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 
@@ -684,19 +772,19 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for sap profile: %v", err)
+			return fmt.Errorf("failed to update the lpar with the change for sap profile: %v", err)
 		}
 
 		// Wait for the resize to complete and status to reset
 		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
 		// Start the lpar
 		err := startLparAfterResourceChange(ctx, client, instanceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 	if d.HasChange(PIInstanceStoragePoolAffinity) {
@@ -707,7 +795,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		// This is a synchronous process hence no need to check for health status
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
@@ -729,7 +817,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			if err != nil {
 				// ignore delete member error where the server is already not in the PG
 				if !strings.Contains(err.Error(), "is not part of placement-group") {
-					return diag.FromErr(err)
+					return err
 				}
 			}
 		}
@@ -742,13 +830,50 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			}
 			_, err := pgClient.AddMember(placementGroupID, body)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return nil
+}
+
+// piInstanceIDs returns the IDs of all PVM instances tracked by the resource, starting
+// with the instance of the resource ID. States written before the replicants were
+// tracked only know the first instance.
+func piInstanceIDs(d *schema.ResourceData, instanceID string) []string {
+	ids := []string{instanceID}
+	for _, v := range d.Get(Attr_PIInstanceInstances).([]interface{}) {
+		if v == nil {
+			continue
+		}
+		id := v.(map[string]interface{})[Attr_PIInstanceInstanceID].(string)
+		if id != "" && id != instanceID {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func flattenPIInstanceReplicant(id string, pvm *models.PVMInstance) map[string]interface{} {
+	replicant := map[string]interface{}{
+		Attr_PIInstanceInstanceID: id,
+	}
+	if pvm.ServerName != nil {
+		replicant[Attr_PIInstanceInstanceName] = *pvm.ServerName
+	}
+	if pvm.Status != nil {
+		replicant[Attr_PIInstanceInstanceStatus] = *pvm.Status
+	}
+	return replicant
+}
 
+func isPIInstanceShutoff(client *st.IBMPIInstanceClient, id string) bool {
+	pvm, err := client.Get(id)
+	if err != nil || pvm.Status == nil {
+		return false
+	}
+	return *pvm.Status == StatusShutoff
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -763,14 +888,24 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-	err = client.Delete(instanceID)
-	if err != nil {
-		return diag.FromErr(err)
+	instanceIDs := piInstanceIDs(d, instanceID)
+	for _, id := range instanceIDs {
+		err = client.Delete(id)
+		if err != nil {
+			uErr := errors.Unwrap(err)
+			if _, ok := uErr.(*p_cloud_p_vm_instances.PcloudPvminstancesDeleteNotFound); ok {
+				log.Printf("[DEBUG] pvm instance %s does not exist %v", id, err)
+				continue
+			}
+			return diag.FromErr(err)
+		}
 	}
 
-	_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID)
-	if err != nil {
-		return diag.FromErr(err)
+	for _, id := range instanceIDs {
+		_, err = isWaitForPIInstanceDeleted(ctx, client, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	d.SetId("")
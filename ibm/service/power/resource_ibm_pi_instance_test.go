@@ -410,3 +410,59 @@ func testAccIBMPIInstanceMixedStorage(name string) string {
 	}
 	`, acc.Pi_cloud_instance_id, name)
 }
+
+func TestAccIBMPIInstanceReplicants(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-replicants-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMPIInstanceReplicantsConfig(name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "instances.#", "2"),
+					resource.TestCheckResourceAttrSet(instanceRes, "instances.1.instance_id"),
+				),
+			},
+			{
+				Config: testAccIBMPIInstanceReplicantsConfig(name, "4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_memory", "4"),
+					resource.TestCheckResourceAttr(instanceRes, "instances.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMPIInstanceReplicantsConfig(name, memory string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_image_name        = "%[3]s"
+		pi_cloud_instance_id = "%[1]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_memory             = "%[5]s"
+		pi_processors         = "0.25"
+		pi_instance_name      = "%[2]s"
+		pi_proc_type          = "shared"
+		pi_image_id           = data.ibm_pi_image.power_image.id
+		pi_sys_type           = "s922"
+		pi_cloud_instance_id  = "%[1]s"
+		pi_storage_pool       = data.ibm_pi_image.power_image.storage_pool
+		pi_replicants         = 2
+		pi_replication_policy = "none"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, memory)
+}
@@ -6,6 +6,7 @@ package power
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -14,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/sl"
 )
@@ -48,7 +50,7 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 				ForceNew:    true,
 				MaxItems:    1,
 				MinItems:    1,
-				Description: "Performs an action (start stop reset ) on a volume group(one at a time).",
+				Description: "Performs an action (start stop reset failover failback) on a volume group(one at a time).",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"start": {
@@ -95,6 +97,33 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 								},
 							},
 						},
+						"failover": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							ForceNew:    true,
+							Description: "Fails over to the auxiliary volumes; run it against the volume group on the DR site",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"restart_replication": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Restart the replication from the auxiliary volumes after the failover",
+									},
+								},
+							},
+						},
+						"failback": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							ForceNew:    true,
+							Description: "Fails back to the master volumes after a failover and restarts the replication from them",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
 					},
 				},
 			},
@@ -115,6 +144,16 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 				Computed:    true,
 				Description: "Volume Group Replication Status",
 			},
+			"consistency_group_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the consistency group at storage controller level",
+			},
+			"primary_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether the master or the auxiliary volumes are the primary of the consistency group",
+			},
 		},
 	}
 }
@@ -126,16 +165,24 @@ func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	vgID := d.Get(PIVolumeGroupID).(string)
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+
+	if failover, source := expandVolumeGroupFailoverAction(d.Get(PIVolumeGroupAction).([]interface{})); failover != nil {
+		err = performVolumeGroupFailover(ctx, client, vgID, source, failover["restart_replication"].(bool), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+		return resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
+	}
+
 	vgAction, err := expandVolumeGroupAction(d.Get(PIVolumeGroupAction).([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
-	body := vgAction
-
-	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
-	_, err = client.VolumeGroupAction(vgID, body)
+	_, err = client.VolumeGroupAction(vgID, vgAction)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -172,6 +219,16 @@ func resourceIBMPIVolumeGroupActionRead(ctx context.Context, d *schema.ResourceD
 	d.Set("volume_group_status", vg.Status)
 	d.Set("replication_status", vg.ReplicationStatus)
 
+	// the storage controller is only queried for the replication actions
+	if failover, _ := expandVolumeGroupFailoverAction(d.Get(PIVolumeGroupAction).([]interface{})); failover != nil {
+		storageDetails, err := client.GetVolumeGroupLiveDetails(vgID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("consistency_group_state", storageDetails.State)
+		d.Set("primary_role", storageDetails.PrimaryRole)
+	}
+
 	return nil
 }
 
@@ -242,3 +299,103 @@ func expandVolumeGroupResetAction(reset []interface{}) *models.VolumeGroupAction
 		Status: sl.String(s["status"].(string)),
 	}
 }
+
+// expandVolumeGroupFailoverAction returns the failover or failback block and the
+// replication source the consistency group is restarted from
+func expandVolumeGroupFailoverAction(data []interface{}) (map[string]interface{}, string) {
+	if len(data) == 0 || data[0] == nil {
+		return nil, ""
+	}
+	action := data[0].(map[string]interface{})
+
+	if v, ok := action["failover"]; ok && len(v.([]interface{})) != 0 {
+		return volumeGroupActionBlock(v.([]interface{})), "aux"
+	}
+	if v, ok := action["failback"]; ok && len(v.([]interface{})) != 0 {
+		// a failback always restarts the replication, otherwise it is the same stop as a failover
+		return map[string]interface{}{"restart_replication": true}, "master"
+	}
+	return nil, ""
+}
+
+func volumeGroupActionBlock(block []interface{}) map[string]interface{} {
+	if block[0] == nil {
+		// an empty block keeps the defaults
+		return map[string]interface{}{"restart_replication": true}
+	}
+	return block[0].(map[string]interface{})
+}
+
+// performVolumeGroupFailover stops the consistency group with access to the auxiliary
+// volumes enabled, waits for the group to be idling and then optionally restarts the
+// replication from the given source and waits for the group to be consistent again.
+func performVolumeGroupFailover(ctx context.Context, client *st.IBMPIVolumeGroupClient, vgID, source string, restartReplication bool, timeout time.Duration) error {
+	stop := &models.VolumeGroupAction{
+		Stop: &models.VolumeGroupActionStop{
+			Access: sl.Bool(true),
+		},
+	}
+	_, err := client.VolumeGroupAction(vgID, stop)
+	if err != nil {
+		return fmt.Errorf("failed to stop the replication of volume group %s: %v", vgID, err)
+	}
+	_, err = isWaitForIBMPIVolumeGroupReplicationState(ctx, client, vgID, volumeGroupIdlingStates, timeout)
+	if err != nil {
+		return err
+	}
+	if !restartReplication {
+		return nil
+	}
+
+	start := &models.VolumeGroupAction{
+		Start: &models.VolumeGroupActionStart{
+			Source: sl.String(source),
+		},
+	}
+	_, err = client.VolumeGroupAction(vgID, start)
+	if err != nil {
+		return fmt.Errorf("failed to start the replication of volume group %s from %s: %v", vgID, source, err)
+	}
+	_, err = isWaitForIBMPIVolumeGroupReplicationState(ctx, client, vgID, volumeGroupConsistentStates, timeout)
+	return err
+}
+
+var (
+	volumeGroupIdlingStates     = []string{"idling", "idling_disconnected"}
+	volumeGroupConsistentStates = []string{"consistent_copying", "consistent_synchronized"}
+)
+
+func isWaitForIBMPIVolumeGroupReplicationState(ctx context.Context, client *st.IBMPIVolumeGroupClient, id string, states []string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) consistency group to be %v.", id, states)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", helpers.PIVolumeProvisioning},
+		Target:     []string{helpers.PIVolumeProvisioningDone},
+		Refresh:    isIBMPIVolumeGroupReplicationStateRefreshFunc(client, id, states),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupReplicationStateRefreshFunc(client *st.IBMPIVolumeGroupClient, id string, states []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		details, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, state := range states {
+			if details.State == state {
+				return details, helpers.PIVolumeProvisioningDone, nil
+			}
+		}
+		if details.State == "consistent_disconnected" || details.State == "inconsistent_disconnected" {
+			return details, details.State, fmt.Errorf("the consistency group of volume group %s is %s", id, details.State)
+		}
+
+		return details, helpers.PIVolumeProvisioning, nil
+	}
+}
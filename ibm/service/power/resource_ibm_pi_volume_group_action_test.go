@@ -105,3 +105,41 @@ func testAccCheckIBMPIVolumeGroupStartActionConfig(name string) string {
 	  }
 	`, acc.Pi_cloud_instance_id)
 }
+
+func TestAccIBMPIVolumeGroupActionFailover(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailoverActionConfig("failover"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupActionExists("ibm_pi_volume_group_action.power_volume_group_action"),
+					resource.TestCheckResourceAttr("ibm_pi_volume_group_action.power_volume_group_action", "primary_role", "aux"),
+					resource.TestCheckResourceAttrSet("ibm_pi_volume_group_action.power_volume_group_action", "consistency_group_state"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailoverActionConfig("failback"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupActionExists("ibm_pi_volume_group_action.power_volume_group_action"),
+					resource.TestCheckResourceAttr("ibm_pi_volume_group_action.power_volume_group_action", "primary_role", "master"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeGroupFailoverActionConfig(action string) string {
+	return fmt.Sprintf(`
+	  resource "ibm_pi_volume_group_action" "power_volume_group_action" {
+		pi_cloud_instance_id   = "%[1]s"
+		pi_volume_group_id     = "%[2]s"
+		pi_volume_group_action {
+			%[3]s {
+				restart_replication = true
+			}
+		}
+	  }
+	`, acc.Pi_cloud_instance_id, acc.Pi_volume_group_id, action)
+}
@@ -0,0 +1,329 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMPIWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIWorkspaceCreate,
+		ReadContext:   resourceIBMPIWorkspaceRead,
+		UpdateContext: resourceIBMPIWorkspaceUpdate,
+		DeleteContext: resourceIBMPIWorkspaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_WorkspaceName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name of the workspace",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_WorkspaceDatacenter: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Datacenter where the workspace is provisioned, for example dal12",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Optional Arguments
+			Arg_WorkspaceResourceGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group; defaults to the account default resource group",
+			},
+			Arg_WorkspacePlan: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     WorkspaceDefaultPlan,
+				Description: "Name of the Power Virtual Server service plan",
+			},
+
+			// Attributes
+			Attr_WorkspaceCapabilities: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Capabilities of the workspace",
+			},
+			Attr_WorkspaceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the workspace",
+			},
+			Attr_WorkspacePlanID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the service plan of the workspace",
+			},
+			Attr_WorkspaceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the workspace",
+			},
+		},
+	}
+}
+
+func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(Arg_WorkspaceName).(string)
+	datacenter := d.Get(Arg_WorkspaceDatacenter).(string)
+	plan := d.Get(Arg_WorkspacePlan).(string)
+
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	serviceOff, err := rsCatRepo.FindByName(WorkspaceServiceName, true)
+	if err != nil || len(serviceOff) == 0 {
+		return diag.Errorf("error retrieving service offering %s: %v", WorkspaceServiceName, err)
+	}
+	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
+	if err != nil {
+		return diag.Errorf("error retrieving plan %s: %v", plan, err)
+	}
+	deployments, err := rsCatRepo.ListDeployments(servicePlan)
+	if err != nil {
+		return diag.Errorf("error retrieving deployments for plan %s: %v", plan, err)
+	}
+
+	// the datacenter is the deployment location of the power-iaas service
+	var target string
+	locations := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		if !deployment.Metadata.RCCompatible {
+			continue
+		}
+		locations = append(locations, deployment.Metadata.Deployment.Location)
+		if deployment.Metadata.Deployment.Location == datacenter {
+			target = deployment.CatalogCRN
+		}
+	}
+	if target == "" {
+		return diag.Errorf("datacenter %s is not available for plan %s, valid datacenters are: %q", datacenter, plan, locations)
+	}
+
+	rsInst := &rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		Target:         &target,
+		ResourcePlanID: &servicePlan,
+	}
+	if rsGrpID, ok := d.GetOk(Arg_WorkspaceResourceGroupID); ok {
+		rg := rsGrpID.(string)
+		rsInst.ResourceGroup = &rg
+	} else {
+		defaultRg, err := flex.DefaultResourceGroup(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rsInst.ResourceGroup = &defaultRg
+	}
+
+	instance, response, err := rsConClient.CreateResourceInstance(rsInst)
+	if err != nil {
+		log.Printf("[DEBUG] create workspace failed %v", response)
+		return diag.Errorf("error creating workspace %s: %v", name, err)
+	}
+
+	// the GUID of the resource instance is the pi_cloud_instance_id used by all power resources
+	d.SetId(*instance.GUID)
+
+	_, err = waitForIBMPIWorkspaceAvailable(ctx, rsConClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Id()
+	instance, response, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[DEBUG] workspace does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving workspace %s: %v", instanceID, err)
+	}
+	if *instance.State == "removed" || *instance.State == "pending_reclamation" {
+		log.Printf("[WARN] Removing workspace %s from state because it is %s", instanceID, *instance.State)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(Arg_WorkspaceName, instance.Name)
+	d.Set(Arg_WorkspaceResourceGroupID, instance.ResourceGroupID)
+	d.Set(Attr_WorkspaceCRN, instance.CRN)
+	d.Set(Attr_WorkspacePlanID, instance.ResourcePlanID)
+	d.Set(Attr_WorkspaceStatus, instance.State)
+	if instance.CRN != nil {
+		crn := strings.Split(*instance.CRN, ":")
+		if len(crn) > 5 {
+			d.Set(Arg_WorkspaceDatacenter, crn[5])
+		}
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := st.NewIBMPICloudInstanceClient(ctx, sess, instanceID)
+	cloudInstance, err := client.Get(instanceID)
+	if err != nil {
+		return diag.Errorf("error retrieving capabilities of workspace %s: %v", instanceID, err)
+	}
+	d.Set(Attr_WorkspaceCapabilities, cloudInstance.Capabilities)
+
+	return nil
+}
+
+func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(Arg_WorkspaceName) {
+		instanceID := d.Id()
+		name := d.Get(Arg_WorkspaceName).(string)
+		_, response, err := rsConClient.UpdateResourceInstance(&rc.UpdateResourceInstanceOptions{
+			ID:   &instanceID,
+			Name: &name,
+		})
+		if err != nil {
+			log.Printf("[DEBUG] update workspace failed %v", response)
+			return diag.Errorf("error updating workspace %s: %v", instanceID, err)
+		}
+		_, err = waitForIBMPIWorkspaceAvailable(ctx, rsConClient, instanceID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Id()
+	recursive := true
+	response, err := rsConClient.DeleteResourceInstance(&rc.DeleteResourceInstanceOptions{
+		ID:        &instanceID,
+		Recursive: &recursive,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error deleting workspace %s: %v", instanceID, err)
+	}
+
+	_, err = waitForIBMPIWorkspaceDeleted(ctx, rsConClient, instanceID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForIBMPIWorkspaceAvailable(ctx context.Context, client *rc.ResourceControllerV2, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"provisioning", "in progress", "inactive"},
+		Target:  []string{WorkspaceStatusActive},
+		Refresh: func() (interface{}, string, error) {
+			instance, response, err := client.GetResourceInstance(&rc.GetResourceInstanceOptions{
+				ID: &id,
+			})
+			if err != nil {
+				log.Printf("[DEBUG] get workspace failed %v", response)
+				return nil, "", err
+			}
+			if *instance.State == WorkspaceStatusFailed {
+				return instance, *instance.State, fmt.Errorf("workspace %s failed to provision", id)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func waitForIBMPIWorkspaceDeleted(ctx context.Context, client *rc.ResourceControllerV2, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", WorkspaceStatusActive, "in progress", "inactive"},
+		Target:  []string{"removed", "pending_reclamation"},
+		Refresh: func() (interface{}, string, error) {
+			instance, response, err := client.GetResourceInstance(&rc.GetResourceInstanceOptions{
+				ID: &id,
+			})
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return instance, "removed", nil
+				}
+				log.Printf("[DEBUG] get workspace failed %v", response)
+				return nil, "", err
+			}
+			if *instance.State == WorkspaceStatusFailed {
+				return instance, *instance.State, fmt.Errorf("workspace %s failed to delete", id)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
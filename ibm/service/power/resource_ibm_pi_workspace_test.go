@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIWorkspaceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-workspace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.workspace"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.workspace", "pi_name", name),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.workspace", "status", "active"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.workspace", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_workspace" "workspace" {
+		pi_name              = "%[1]s"
+		pi_datacenter        = "%[2]s"
+		pi_resource_group_id = "%[3]s"
+	}`, name, acc.Pi_datacenter, acc.IsResourceGroupID)
+}
+
+func testAccCheckIBMPIWorkspaceDestroy(s *terraform.State) error {
+	client, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_workspace" {
+			continue
+		}
+		id := rs.Primary.ID
+		instance, _, err := client.GetResourceInstance(&rc.GetResourceInstanceOptions{ID: &id})
+		if err == nil && *instance.State == "active" {
+			return fmt.Errorf("PI workspace still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPIWorkspaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+		client, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		id := rs.Primary.ID
+		_, _, err = client.GetResourceInstance(&rc.GetResourceInstanceOptions{ID: &id})
+		return err
+	}
+}
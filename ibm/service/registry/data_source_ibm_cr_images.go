@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func DataIBMContainerRegistryImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the images in the namespace.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the images in the repository, such as us.icr.io/namespace/repository.",
+			},
+			"include_ibm": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Includes IBM-provided public images in the list of images.",
+			},
+			"include_private": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Includes private images in the list of images.",
+			},
+			"vulnerabilities": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Includes the Vulnerability Advisor status of the images.",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Container Registry Images",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the image configuration.",
+						},
+						"digest": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The digest of the image manifest.",
+						},
+						"repo_digests": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The image references by digest, such as us.icr.io/namespace/repository@sha256:digest.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The image references by tag, such as us.icr.io/namespace/repository:tag.",
+						},
+						"created": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the image was created.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the image in bytes.",
+						},
+						"manifest_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the image manifest.",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels of the image.",
+						},
+						"va_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vulnerability Advisor status of the image.",
+						},
+						"issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of security issues of the image that are not exempted.",
+						},
+						"vulnerability_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vulnerabilities of the image that are not exempted.",
+						},
+						"configuration_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of configuration issues of the image that are not exempted.",
+						},
+						"exempt_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of exempted security issues of the image.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImagesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listImagesOptions := &containerregistryv1.ListImagesOptions{}
+	if v, ok := d.GetOk("namespace"); ok {
+		listImagesOptions.SetNamespace(v.(string))
+	}
+	if v, ok := d.GetOk("repository"); ok {
+		listImagesOptions.SetRepository(v.(string))
+	}
+	listImagesOptions.SetIncludeIBM(d.Get("include_ibm").(bool))
+	listImagesOptions.SetIncludePrivate(d.Get("include_private").(bool))
+	listImagesOptions.SetVulnerabilities(d.Get("vulnerabilities").(bool))
+
+	imageList, _, err := containerRegistryClient.ListImagesWithContext(context, listImagesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	images := []map[string]interface{}{}
+	for _, remoteImage := range imageList {
+		image := map[string]interface{}{}
+		image["id"] = remoteImage.ID
+		if len(remoteImage.RepoDigests) > 0 {
+			repoDigest := remoteImage.RepoDigests[0]
+			image["digest"] = repoDigest[strings.LastIndex(repoDigest, "@")+1:]
+		}
+		image["repo_digests"] = remoteImage.RepoDigests
+		image["tags"] = remoteImage.RepoTags
+		if remoteImage.Created != nil {
+			image["created"] = time.Unix(*remoteImage.Created, 0).UTC().Format(time.RFC3339)
+		}
+		image["size"] = flex.IntValue(remoteImage.Size)
+		image["manifest_type"] = remoteImage.ManifestType
+		image["labels"] = remoteImage.Labels
+		image["va_status"] = remoteImage.Vulnerable
+		image["issue_count"] = flex.IntValue(remoteImage.IssueCount)
+		image["vulnerability_count"] = flex.IntValue(remoteImage.VulnerabilityCount)
+		image["configuration_issue_count"] = flex.IntValue(remoteImage.ConfigurationIssueCount)
+		image["exempt_issue_count"] = flex.IntValue(remoteImage.ExemptIssueCount)
+		images = append(images, image)
+	}
+	if err = d.Set("images", images); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting images: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerRegistryImagesDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerRegistryImagesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "images.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "images.0.digest"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "images.0.size"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "images.0.va_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerRegistryImagesDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_cr_images" "images" {
+			namespace = split("/", "%s")[1]
+		}
+	`, acc.CrImage)
+}
@@ -0,0 +1,363 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv3"
+)
+
+// vaSeverities are the Vulnerability Advisor severities, from the lowest to
+// the highest.
+var vaSeverities = []string{"low", "medium", "high", "critical"}
+
+// vaCompletedStatuses are the report statuses of an image that was fully
+// scanned.
+var vaCompletedStatuses = []string{"OK", "WARN", "FAIL"}
+
+func DataIBMContainerRegistryVaReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryVaReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"image": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The image to get the Vulnerability Advisor report of, by tag or by digest, such as us.icr.io/namespace/repository:tag.",
+			},
+			"fail_on_vulnerabilities": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fails the read, and so the plan, when the image wasn't fully scanned or has vulnerabilities at or above severity_threshold that are not exempted.",
+			},
+			"severity_threshold": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "critical",
+				ValidateFunc: validate.ValidateAllowedStringValues(vaSeverities),
+				Description:  "The lowest severity of the vulnerabilities that fail the read when fail_on_vulnerabilities is set. Vulnerabilities that the report doesn't rate don't fail the read.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Vulnerability Advisor status of the image, such as OK, WARN, FAIL or UNSCANNED.",
+			},
+			"scan_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the image was scanned.",
+			},
+			"vulnerability_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vulnerabilities of the image that are not exempted.",
+			},
+			"exempt_vulnerability_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of exempted vulnerabilities of the image.",
+			},
+			"configuration_issue_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of configuration issues of the image that are not exempted.",
+			},
+			"vulnerabilities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The vulnerabilities found in the image.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cve_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the CVE.",
+						},
+						"summary": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The summary of the CVE.",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The severity of the CVE, empty when the report doesn't rate it.",
+						},
+						"exempt": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the CVE is exempted.",
+						},
+						"security_notices": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The security notices that address the CVE.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"notice_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the security notice.",
+									},
+									"summary": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The summary of the security notice.",
+									},
+									"exempt": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the security notice is exempted.",
+									},
+									"vulnerable_packages": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The packages of the image the security notice applies to.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"package_name": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The name of the package.",
+												},
+												"installed_version": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The version of the package installed in the image.",
+												},
+												"fix_version": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The version of the package that fixes the vulnerability.",
+												},
+												"corrective_action": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The action to take to fix the vulnerability.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"configuration_issues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The configuration issues found in the image.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the configuration issue.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the configuration issue.",
+						},
+						"corrective_action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action to take to fix the configuration issue.",
+						},
+						"exempt": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the configuration issue is exempted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryVaReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image := d.Get("image").(string)
+	report, severities, err := getVaImageReport(context, vulnerabilityAdvisorClient, image)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vulnerabilities := []map[string]interface{}{}
+	unexempted := []string{}
+	failing := []string{}
+	exemptCount := 0
+	threshold := d.Get("severity_threshold").(string)
+	for _, cve := range report.Vulnerabilities {
+		severity := severities[core.StringNilMapper(cve.CveID)]
+		vulnerability := map[string]interface{}{}
+		vulnerability["cve_id"] = cve.CveID
+		vulnerability["summary"] = cve.Summary
+		vulnerability["severity"] = severity
+		vulnerability["exempt"] = cve.CveExempt
+		notices := []map[string]interface{}{}
+		for _, securityNotice := range cve.SecurityNotices {
+			notice := map[string]interface{}{}
+			notice["notice_id"] = securityNotice.NoticeID
+			notice["summary"] = securityNotice.Summary
+			notice["exempt"] = securityNotice.NoticeExempt
+			packages := []map[string]interface{}{}
+			for _, packageFix := range securityNotice.VulnerablePackages {
+				packages = append(packages, map[string]interface{}{
+					"package_name":      packageFix.PackageName,
+					"installed_version": packageFix.InstalledVersion,
+					"fix_version":       packageFix.FixVersion,
+					"corrective_action": packageFix.CorrectiveAction,
+				})
+			}
+			notice["vulnerable_packages"] = packages
+			notices = append(notices, notice)
+		}
+		vulnerability["security_notices"] = notices
+		vulnerabilities = append(vulnerabilities, vulnerability)
+
+		if cve.CveExempt != nil && *cve.CveExempt {
+			exemptCount++
+		} else if cve.CveID != nil {
+			unexempted = append(unexempted, *cve.CveID)
+			if vaSeverityAtLeast(severity, threshold) {
+				failing = append(failing, *cve.CveID)
+			}
+		}
+	}
+
+	configurationIssues := []map[string]interface{}{}
+	configurationIssueCount := 0
+	for _, issue := range report.ConfigurationIssues {
+		configurationIssues = append(configurationIssues, map[string]interface{}{
+			"type":              issue.Type,
+			"description":       issue.Description,
+			"corrective_action": issue.CorrectiveAction,
+			"exempt":            issue.Exempt,
+		})
+		if issue.Exempt == nil || !*issue.Exempt {
+			configurationIssueCount++
+		}
+	}
+
+	// An image that wasn't scanned, or is still being scanned, has no known
+	// vulnerabilities yet, so it must not pass the gate.
+	if d.Get("fail_on_vulnerabilities").(bool) {
+		if !vaScanCompleted(core.StringNilMapper(report.Status)) {
+			return diag.FromErr(fmt.Errorf("[ERROR] Image %s has Vulnerability Advisor status %s and wasn't fully scanned", image, core.StringNilMapper(report.Status)))
+		}
+		if len(failing) > 0 {
+			return diag.FromErr(fmt.Errorf("[ERROR] Image %s has %d vulnerabilities of severity %s or higher that are not exempted: %s", image, len(failing), threshold, strings.Join(failing, ", ")))
+		}
+	}
+
+	if err = d.Set("status", report.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status: %s", err))
+	}
+	if report.ScanTime != nil {
+		d.Set("scan_time", time.Unix(*report.ScanTime, 0).UTC().Format(time.RFC3339))
+	}
+	d.Set("vulnerability_count", len(unexempted))
+	d.Set("exempt_vulnerability_count", exemptCount)
+	d.Set("configuration_issue_count", configurationIssueCount)
+	if err = d.Set("vulnerabilities", vulnerabilities); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vulnerabilities: %s", err))
+	}
+	if err = d.Set("configuration_issues", configurationIssues); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting configuration_issues: %s", err))
+	}
+	d.SetId(image)
+	return nil
+}
+
+// getVaImageReport returns the Vulnerability Advisor report of the image and
+// the severity of its CVEs. The SDK doesn't model the severity, so the report
+// is requested with the same call as ImageReportQueryPath and the severity is
+// read from the raw vulnerabilities.
+func getVaImageReport(context context.Context, vulnerabilityAdvisorClient *vulnerabilityadvisorv3.VulnerabilityAdvisorV3, image string) (*vulnerabilityadvisorv3.ScanReport, map[string]string, error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = vulnerabilityAdvisorClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(vulnerabilityAdvisorClient.Service.Options.URL, `/va/api/v3/report/image/{name}`, map[string]string{"name": image})
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if vulnerabilityAdvisorClient.Account != nil {
+		builder.AddHeader("Account", fmt.Sprint(*vulnerabilityAdvisorClient.Account))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err := vulnerabilityAdvisorClient.Service.Request(request, &rawResponse)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ImageReportQueryPathWithContext failed %s\n%s", err, response)
+	}
+	var report *vulnerabilityadvisorv3.ScanReport
+	if err = core.UnmarshalModel(rawResponse, "", &report, vulnerabilityadvisorv3.UnmarshalScanReport); err != nil {
+		return nil, nil, err
+	}
+
+	var rated []struct {
+		CveID    string `json:"cve_id"`
+		Severity string `json:"severity"`
+	}
+	severities := map[string]string{}
+	if raw, ok := rawResponse["vulnerabilities"]; ok {
+		if err = json.Unmarshal(raw, &rated); err != nil {
+			return nil, nil, err
+		}
+		for _, cve := range rated {
+			severities[cve.CveID] = strings.ToLower(cve.Severity)
+		}
+	}
+	return report, severities, nil
+}
+
+// vaSeverityAtLeast returns whether the severity is at or above the threshold.
+// Only the severities the report rates are gated, so a severity that isn't
+// rated, or isn't known, is never at or above the threshold.
+func vaSeverityAtLeast(severity string, threshold string) bool {
+	rank := func(s string) int {
+		for i, known := range vaSeverities {
+			if strings.EqualFold(s, known) {
+				return i
+			}
+		}
+		return -1
+	}
+	severityRank := rank(severity)
+	return severityRank >= 0 && severityRank >= rank(threshold)
+}
+
+func vaScanCompleted(status string) bool {
+	for _, completed := range vaCompletedStatuses {
+		if strings.EqualFold(status, completed) {
+			return true
+		}
+	}
+	return false
+}
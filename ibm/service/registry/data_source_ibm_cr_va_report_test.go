@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerRegistryVaReportDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerRegistryVaReportDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cr_va_report.va_report", "id", acc.CrImage),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_va_report.va_report", "status"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_va_report.va_report", "vulnerability_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_va_report.va_report", "vulnerabilities.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerRegistryVaReportDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_cr_va_report" "va_report" {
+			image = "%s"
+		}
+	`, acc.CrImage)
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+const bytesPerMegabyte = 1024 * 1024
+
+func ResourceIBMCrAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrAccountSettingsUpdate,
+		ReadContext:   resourceIBMCrAccountSettingsRead,
+		UpdateContext: resourceIBMCrAccountSettingsUpdate,
+		DeleteContext: resourceIBMCrAccountSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"storage_quota_megabytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The storage quota of the account in megabytes. The value -1 denotes 'Unlimited'.",
+			},
+			"traffic_quota_megabytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The monthly pull traffic quota of the account in megabytes. The value -1 denotes 'Unlimited'.",
+			},
+			"platform_metrics": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the registry metrics of the account are sent to IBM Cloud Monitoring.",
+			},
+			"private_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the registry of the account can only be reached from private networks.",
+			},
+			"storage_usage_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The storage used by the account in bytes.",
+			},
+			"traffic_usage_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The pull traffic of the account in the current month in bytes.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrAccountSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quota, response, err := containerRegistryClient.GetQuotaWithContext(context, &containerregistryv1.GetQuotaOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	if quota.Limit != nil {
+		d.Set("storage_quota_megabytes", quotaMegabytes(quota.Limit.StorageBytes))
+		d.Set("traffic_quota_megabytes", quotaMegabytes(quota.Limit.TrafficBytes))
+	}
+	if quota.Usage != nil {
+		d.Set("storage_usage_bytes", quota.Usage.StorageBytes)
+		d.Set("traffic_usage_bytes", quota.Usage.TrafficBytes)
+	}
+
+	settings, response, err := containerRegistryClient.GetSettingsWithContext(context, &containerregistryv1.GetSettingsOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	d.Set("platform_metrics", settings.PlatformMetrics)
+
+	auth, response, err := containerRegistryClient.GetAuthWithContext(context, &containerregistryv1.GetAuthOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetAuthWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	d.Set("private_only", auth.PrivateOnly)
+
+	return nil
+}
+
+func resourceIBMCrAccountSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateQuotaOptions := &containerregistryv1.UpdateQuotaOptions{}
+	hasQuotaChange := false
+	if v, ok := d.GetOkExists("storage_quota_megabytes"); ok && d.HasChange("storage_quota_megabytes") {
+		updateQuotaOptions.SetStorageMegabytes(int64(v.(int)))
+		hasQuotaChange = true
+	}
+	if v, ok := d.GetOkExists("traffic_quota_megabytes"); ok && d.HasChange("traffic_quota_megabytes") {
+		updateQuotaOptions.SetTrafficMegabytes(int64(v.(int)))
+		hasQuotaChange = true
+	}
+	if hasQuotaChange {
+		response, err := containerRegistryClient.UpdateQuotaWithContext(context, updateQuotaOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateQuotaWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOkExists("platform_metrics"); ok && d.HasChange("platform_metrics") {
+		updateSettingsOptions := &containerregistryv1.UpdateSettingsOptions{}
+		updateSettingsOptions.SetPlatformMetrics(v.(bool))
+		response, err := containerRegistryClient.UpdateSettingsWithContext(context, updateSettingsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOkExists("private_only"); ok && d.HasChange("private_only") {
+		updateAuthOptions := &containerregistryv1.UpdateAuthOptions{}
+		updateAuthOptions.SetPrivateOnly(v.(bool))
+		response, err := containerRegistryClient.UpdateAuthWithContext(context, updateAuthOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateAuthWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(*containerRegistryClient.Account)
+	}
+
+	return resourceIBMCrAccountSettingsRead(context, d, meta)
+}
+
+// The settings are kept when the resource is destroyed, as the account
+// always has registry settings.
+func resourceIBMCrAccountSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+func quotaMegabytes(bytes *int64) int64 {
+	if bytes == nil || *bytes < 0 {
+		return -1
+	}
+	return *bytes / bytesPerMegabyte
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrAccountSettingsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrAccountSettingsConfig(1000, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_account_settings.cr_account_settings", "storage_quota_megabytes", "1000"),
+					resource.TestCheckResourceAttr("ibm_cr_account_settings.cr_account_settings", "platform_metrics", "true"),
+					resource.TestCheckResourceAttrSet("ibm_cr_account_settings.cr_account_settings", "traffic_quota_megabytes"),
+					resource.TestCheckResourceAttrSet("ibm_cr_account_settings.cr_account_settings", "private_only"),
+					resource.TestCheckResourceAttrSet("ibm_cr_account_settings.cr_account_settings", "storage_usage_bytes"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCrAccountSettingsConfig(-1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_account_settings.cr_account_settings", "storage_quota_megabytes", "-1"),
+					resource.TestCheckResourceAttr("ibm_cr_account_settings.cr_account_settings", "platform_metrics", "false"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cr_account_settings.cr_account_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrAccountSettingsConfig(storageQuota int, platformMetrics bool) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_account_settings" "cr_account_settings" {
+			storage_quota_megabytes = %d
+			platform_metrics = %t
+		}
+	`, storageQuota, platformMetrics)
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func ResourceIBMCrImageTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrImageTagCreate,
+		ReadContext:   resourceIBMCrImageTagRead,
+		DeleteContext: resourceIBMCrImageTagDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"source_image": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The image to tag, by tag or by digest, such as us.icr.io/namespace/repository@sha256:digest.",
+			},
+			"target_image": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The new tag of the image, such as us.icr.io/namespace/repository:tag. The repository and namespace can differ from the source image, but not the registry.",
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the image configuration the target tag refers to.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrImageTagCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tagImageOptions := &containerregistryv1.TagImageOptions{}
+
+	tagImageOptions.SetFromimage(d.Get("source_image").(string))
+	tagImageOptions.SetToimage(d.Get("target_image").(string))
+
+	response, err := containerRegistryClient.TagImageWithContext(context, tagImageOptions)
+	if err != nil {
+		log.Printf("[DEBUG] TagImageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("target_image").(string))
+
+	return resourceIBMCrImageTagRead(context, d, meta)
+}
+
+func resourceIBMCrImageTagRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	inspectImageOptions := &containerregistryv1.InspectImageOptions{}
+
+	inspectImageOptions.SetImage(d.Id())
+
+	target, response, err := containerRegistryClient.InspectImageWithContext(context, inspectImageOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] InspectImageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	// A tag that was moved to another image outside of Terraform is treated as
+	// gone, so that the next apply tags the source image again.
+	if sourceImage, ok := d.GetOk("source_image"); ok {
+		inspectImageOptions.SetImage(sourceImage.(string))
+		source, response, err := containerRegistryClient.InspectImageWithContext(context, inspectImageOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] InspectImageWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		if err == nil && source.ID != nil && target.ID != nil && *source.ID != *target.ID {
+			log.Printf("[WARN] Image tag %s no longer refers to %s", d.Id(), sourceImage)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = d.Set("target_image", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting target_image: %s", err))
+	}
+	if err = d.Set("image_id", target.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting image_id: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCrImageTagDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteImageTagOptions := &containerregistryv1.DeleteImageTagOptions{}
+
+	deleteImageTagOptions.SetImage(d.Id())
+
+	_, response, err := containerRegistryClient.DeleteImageTagWithContext(context, deleteImageTagOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteImageTagWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func TestAccIBMCrImageTagBasic(t *testing.T) {
+	namespace := fmt.Sprintf("tf_namespace_%d", acctest.RandIntRange(10, 100))
+	registry := strings.SplitN(acc.CrImage, "/", 2)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCrImageTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrImageTagConfig(namespace, registry),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCrImageTagExists("ibm_cr_image_tag.cr_image_tag"),
+					resource.TestCheckResourceAttr("ibm_cr_image_tag.cr_image_tag", "source_image", acc.CrImage),
+					resource.TestCheckResourceAttr("ibm_cr_image_tag.cr_image_tag", "target_image", fmt.Sprintf("%s/%s/promoted:tf", registry, namespace)),
+					resource.TestCheckResourceAttrSet("ibm_cr_image_tag.cr_image_tag", "image_id"),
+				),
+			},
+			{
+				ResourceName:            "ibm_cr_image_tag.cr_image_tag",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_image"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImageTagConfig(namespace string, registry string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_namespace" "cr_namespace" {
+			name = "%s"
+		}
+
+		resource "ibm_cr_image_tag" "cr_image_tag" {
+			source_image = "%s"
+			target_image = "%s/${ibm_cr_namespace.cr_namespace.name}/promoted:tf"
+		}
+	`, namespace, acc.CrImage, registry)
+}
+
+func testAccCheckIBMCrImageTagExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		containerRegistryClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ContainerRegistryV1()
+		if err != nil {
+			return err
+		}
+
+		inspectImageOptions := &containerregistryv1.InspectImageOptions{}
+
+		inspectImageOptions.SetImage(rs.Primary.ID)
+
+		_, _, err = containerRegistryClient.InspectImage(inspectImageOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMCrImageTagDestroy(s *terraform.State) error {
+	containerRegistryClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cr_image_tag" {
+			continue
+		}
+
+		inspectImageOptions := &containerregistryv1.InspectImageOptions{}
+
+		inspectImageOptions.SetImage(rs.Primary.ID)
+
+		_, response, err := containerRegistryClient.InspectImage(inspectImageOptions)
+
+		if err == nil {
+			return fmt.Errorf("cr_image_tag still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for cr_image_tag (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
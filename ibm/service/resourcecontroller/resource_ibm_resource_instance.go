@@ -32,6 +32,10 @@ const (
 	RsInstanceFailStatus         = "failed"
 	RsInstanceRemovedStatus      = "removed"
 	RsInstanceReclamation        = "pending_reclamation"
+
+	RsInstanceReclamationRetain  = "retain"
+	RsInstanceReclamationPurge   = "purge"
+	RsInstanceReclamationRestore = "restore"
 )
 
 func ResourceIBMResourceInstance() *schema.Resource {
@@ -114,6 +118,15 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Description: "Arbitrary parameters to pass in Json string format",
 			},
 
+			"reclamation_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  RsInstanceReclamationRetain,
+				ValidateFunc: validate.InvokeValidator("ibm_resource_instance",
+					"reclamation_policy"),
+				Description: "What happens to the instance in reclamation: retain keeps a deleted instance in reclamation, purge deletes it permanently, and restore also restores a reclaimed instance of the same name, plan, location and resource group instead of creating a new one",
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -229,8 +242,9 @@ func ResourceIBMResourceInstance() *schema.Resource {
 			},
 
 			"locked": {
-				Description: "A boolean that dictates if the resource instance should be deleted (cleaned up) during the processing of a region instance delete call.",
+				Description: "A boolean that dictates if the resource instance is locked. A locked instance cannot be updated or deleted.",
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 			},
 
@@ -389,6 +403,13 @@ func ResourceIBMResourceInstanceValidator() *validate.ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "reclamation_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "retain, purge, restore"})
 
 	ibmResourceInstanceResourceValidator := validate.ResourceValidator{ResourceName: "ibm_resource_instance", Schema: validateSchema}
 	return &ibmResourceInstanceResourceValidator
@@ -503,13 +524,25 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsInst.Parameters = params
 
-	//Start to create resource instance
-	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
-	if err != nil {
-		log.Printf(
-			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
-			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+	var instance *rc.ResourceInstance
+	if d.Get("reclamation_policy").(string) == RsInstanceReclamationRestore {
+		instance, err = RestoreReclaimedResourceInstance(rsConClient, &rsInst, location)
+		if err != nil {
+			return err
+		}
+	}
+	restored := instance != nil
+
+	if instance == nil {
+		//Start to create resource instance
+		created, resp, err := rsConClient.CreateResourceInstance(&rsInst)
+		if err != nil {
+			log.Printf(
+				"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
+				err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
+			return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+		}
+		instance = created
 	}
 
 	d.SetId(*instance.ID)
@@ -519,6 +552,18 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
 	}
 
+	// A restored instance keeps the settings it had when it was deleted
+	if restored {
+		err = UpdateRestoredResourceInstance(rsConClient, d.Id(), &rsInst)
+		if err != nil {
+			return err
+		}
+		_, err = waitForResourceInstanceUpdate(d, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		}
+	}
+
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
 		oldList, newList := d.GetChange("tags")
@@ -529,6 +574,13 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.Get("locked").(bool) {
+		err = setResourceInstanceLock(rsConClient, d.Id(), true)
+		if err != nil {
+			return err
+		}
+	}
+
 	return ResourceIBMResourceInstanceRead(d, meta)
 }
 func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
@@ -660,6 +712,15 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	instanceID := d.Id()
 
+	// A locked instance cannot be updated, so it is unlocked first and only
+	// locked again once the other changes are applied.
+	if d.HasChange("locked") && !d.Get("locked").(bool) {
+		err = setResourceInstanceLock(rsConClient, instanceID, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	resourceInstanceUpdate := rc.UpdateResourceInstanceOptions{
 		ID: &instanceID,
 	}
@@ -766,14 +827,23 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+	if d.HasChanges("name", "plan", "service_endpoints", "parameters", "parameters_json") {
+		_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+		}
+
+		_, err = waitForResourceInstanceUpdate(d, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		}
 	}
 
-	_, err = waitForResourceInstanceUpdate(d, meta)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+	if d.HasChange("locked") && d.Get("locked").(bool) {
+		err = setResourceInstanceLock(rsConClient, instanceID, true)
+		if err != nil {
+			return err
+		}
 	}
 
 	return ResourceIBMResourceInstanceRead(d, meta)
@@ -785,6 +855,15 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	id := d.Id()
+
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &id,
+	}
+	instance, _, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	if err == nil && instance.Locked != nil && *instance.Locked {
+		return fmt.Errorf("[ERROR] The resource instance %s is locked and cannot be deleted. Set locked to false and apply before deleting it", id)
+	}
+
 	recursive := true
 	resourceInstanceDelete := rc.DeleteResourceInstanceOptions{
 		ID:        &id,
@@ -804,6 +883,13 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
 	}
 
+	if d.Get("reclamation_policy").(string) == RsInstanceReclamationPurge {
+		err = purgeResourceInstance(d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -845,7 +931,7 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 	}
 
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus},
+		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus, RsInstanceReclamation},
 		Target:  []string{RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
 			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
@@ -935,6 +1021,152 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 	return stateConf.WaitForState()
 }
 
+// RestoreReclaimedResourceInstance restores the instance in reclamation that
+// has the name, plan, resource group and location of the instance to create.
+// It returns nil when there is no such instance.
+func RestoreReclaimedResourceInstance(rsConClient *rc.ResourceControllerV2, rsInst *rc.CreateResourceInstanceOptions, location string) (*rc.ResourceInstance, error) {
+	state := RsInstanceReclamation
+	listResourceInstances := rc.ListResourceInstancesOptions{
+		Name:            rsInst.Name,
+		ResourceGroupID: rsInst.ResourceGroup,
+		ResourcePlanID:  rsInst.ResourcePlanID,
+		State:           &state,
+	}
+	instances, resp, err := rsConClient.ListResourceInstances(&listResourceInstances)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing resource instances in reclamation: %s with resp code: %s", err, resp)
+	}
+
+	for _, instance := range instances.Resources {
+		crn := strings.Split(*instance.CRN, ":")
+		if len(crn) <= 5 || crn[5] != location {
+			continue
+		}
+
+		listReclamations := rc.ListReclamationsOptions{
+			ResourceInstanceID: instance.ID,
+		}
+		reclamations, resp, err := rsConClient.ListReclamations(&listReclamations)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing reclamations of resource instance %s: %s with resp code: %s", *instance.ID, err, resp)
+		}
+		if len(reclamations.Resources) == 0 {
+			continue
+		}
+
+		action := "restore"
+		runReclamationAction := rc.RunReclamationActionOptions{
+			ID:         reclamations.Resources[0].ID,
+			ActionName: &action,
+		}
+		_, resp, err = rsConClient.RunReclamationAction(&runReclamationAction)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error restoring resource instance %s: %s with resp code: %s", *instance.ID, err, resp)
+		}
+		log.Printf("[INFO] Restored resource instance %s from reclamation", *instance.ID)
+		return &instance, nil
+	}
+
+	return nil, nil
+}
+
+// UpdateRestoredResourceInstance applies the plan, parameters and service
+// endpoints of the instance to create to a restored instance.
+func UpdateRestoredResourceInstance(rsConClient *rc.ResourceControllerV2, instanceID string, rsInst *rc.CreateResourceInstanceOptions) error {
+	resourceInstanceUpdate := rc.UpdateResourceInstanceOptions{
+		ID:             &instanceID,
+		ResourcePlanID: rsInst.ResourcePlanID,
+	}
+	if len(rsInst.Parameters) > 0 {
+		resourceInstanceUpdate.Parameters = rsInst.Parameters
+	}
+	_, resp, err := rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating restored resource instance %s: %s with resp code: %s", instanceID, err, resp)
+	}
+	return nil
+}
+
+// purgeResourceInstance permanently deletes an instance in reclamation.
+func purgeResourceInstance(d *schema.ResourceData, meta interface{}) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	instanceID := d.Id()
+
+	listReclamations := rc.ListReclamationsOptions{
+		ResourceInstanceID: &instanceID,
+	}
+	reclamations, resp, err := rsConClient.ListReclamations(&listReclamations)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing reclamations of resource instance %s: %s with resp code: %s", instanceID, err, resp)
+	}
+	if len(reclamations.Resources) == 0 {
+		return nil
+	}
+
+	for _, reclamation := range reclamations.Resources {
+		action := "reclaim"
+		runReclamationAction := rc.RunReclamationActionOptions{
+			ID:         reclamation.ID,
+			ActionName: &action,
+		}
+		_, resp, err = rsConClient.RunReclamationAction(&runReclamationAction)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error purging resource instance %s: %s with resp code: %s", instanceID, err, resp)
+		}
+	}
+
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{RsInstanceReclamation},
+		Target:  []string{RsInstanceRemovedStatus},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			if err != nil {
+				if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+					return instanceID, RsInstanceRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", instanceID, resp, err)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be purged: %s", instanceID, err)
+	}
+	return nil
+}
+
+func setResourceInstanceLock(rsConClient *rc.ResourceControllerV2, instanceID string, locked bool) error {
+	if locked {
+		lockResourceInstance := rc.LockResourceInstanceOptions{
+			ID: &instanceID,
+		}
+		_, resp, err := rsConClient.LockResourceInstance(&lockResourceInstance)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error locking resource instance %s: %s with resp code: %s", instanceID, err, resp)
+		}
+		return nil
+	}
+
+	unlockResourceInstance := rc.UnlockResourceInstanceOptions{
+		ID: &instanceID,
+	}
+	_, resp, err := rsConClient.UnlockResourceInstance(&unlockResourceInstance)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error unlocking resource instance %s: %s with resp code: %s", instanceID, err, resp)
+	}
+	return nil
+}
+
 func FilterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
 	supportedDeployments := []models.ServiceDeployment{}
 	supportedLocations := make(map[string]bool)
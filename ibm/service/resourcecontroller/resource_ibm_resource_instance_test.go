@@ -4,18 +4,24 @@
 package resourcecontroller_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMResourceInstanceBasic(t *testing.T) {
@@ -146,6 +152,40 @@ func TestAccIBMResourceInstanceWithResourceGroup(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceLocked(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceLocked(serviceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+					resource.TestCheckResourceAttr(resourceName, "reclamation_policy", "purge"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMResourceInstanceLocked(serviceName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("is locked and cannot be deleted"),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceLocked(serviceName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCOSResourceInstanceOneRatePlan(t *testing.T) {
 	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 
@@ -423,3 +463,135 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 			
 	`, serviceName)
 }
+
+func testAccCheckIBMResourceInstanceLocked(serviceName string, locked bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name               = "%s"
+		service            = "kms"
+		plan               = "tiered-pricing"
+		location           = "us-south"
+		locked             = %t
+		reclamation_policy = "purge"
+	}
+	`, serviceName, locked)
+}
+
+// testReclamationHandler mocks the resource controller API with one instance in
+// reclamation and records the restore and update requests it receives.
+func testReclamationHandler(restored *[]string, updates *[]map[string]interface{}) http.HandlerFunc {
+	instanceCRN := "crn:v1:bluemix:public:kms:us-south:a/account:instance-1::"
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/resource_instances":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"rows_count": 1,
+				"resources": []map[string]interface{}{
+					{"id": "instance-1", "crn": instanceCRN, "state": "pending_reclamation"},
+				},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/reclamations":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"resources": []map[string]interface{}{
+					{"id": "reclamation-1", "resource_instance_id": r.URL.Query().Get("resource_instance_id")},
+				},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/reclamations/reclamation-1/actions/restore":
+			*restored = append(*restored, "reclamation-1")
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": "reclamation-1", "state": "RESTORING"})
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/resource_instances/instance-1":
+			update := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&update)
+			*updates = append(*updates, update)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": "instance-1", "crn": instanceCRN})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "not found"}`))
+		}
+	}
+}
+
+func TestRestoreReclaimedResourceInstance(t *testing.T) {
+	testcases := []struct {
+		location string
+		restored bool
+	}{
+		{
+			location: "us-south",
+			restored: true,
+		},
+		{
+			location: "eu-de",
+			restored: false,
+		},
+	}
+
+	for _, c := range testcases {
+		var restored []string
+		var updates []map[string]interface{}
+		server := httptest.NewServer(testReclamationHandler(&restored, &updates))
+		client, err := rc.NewResourceControllerV2(&rc.ResourceControllerV2Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		assert.NilError(t, err)
+		rsInst := &rc.CreateResourceInstanceOptions{
+			Name:           core.StringPtr("kms"),
+			ResourceGroup:  core.StringPtr("group-1"),
+			ResourcePlanID: core.StringPtr("plan-1"),
+		}
+
+		instance, err := resourcecontroller.RestoreReclaimedResourceInstance(client, rsInst, c.location)
+		server.Close()
+		assert.NilError(t, err)
+		if c.restored {
+			assert.Assert(t, instance != nil)
+			assert.Equal(t, "instance-1", *instance.ID)
+			assert.DeepEqual(t, []string{"reclamation-1"}, restored)
+		} else {
+			assert.Assert(t, instance == nil)
+			assert.Equal(t, 0, len(restored))
+		}
+	}
+}
+
+func TestUpdateRestoredResourceInstance(t *testing.T) {
+	testcases := []struct {
+		parameters map[string]interface{}
+		expected   map[string]interface{}
+	}{
+		{
+			parameters: map[string]interface{}{"service-endpoints": "private", "allowed_network": "private-only"},
+			expected: map[string]interface{}{
+				"resource_plan_id": "plan-2",
+				"parameters":       map[string]interface{}{"service-endpoints": "private", "allowed_network": "private-only"},
+			},
+		},
+		{
+			parameters: map[string]interface{}{},
+			expected:   map[string]interface{}{"resource_plan_id": "plan-2"},
+		},
+	}
+
+	for _, c := range testcases {
+		var restored []string
+		var updates []map[string]interface{}
+		server := httptest.NewServer(testReclamationHandler(&restored, &updates))
+		client, err := rc.NewResourceControllerV2(&rc.ResourceControllerV2Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		assert.NilError(t, err)
+		rsInst := &rc.CreateResourceInstanceOptions{
+			ResourcePlanID: core.StringPtr("plan-2"),
+			Parameters:     c.parameters,
+		}
+
+		err = resourcecontroller.UpdateRestoredResourceInstance(client, "instance-1", rsInst)
+		server.Close()
+		assert.NilError(t, err)
+		assert.Equal(t, 1, len(updates))
+		assert.DeepEqual(t, c.expected, updates[0])
+	}
+}
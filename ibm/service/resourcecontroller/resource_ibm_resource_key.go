@@ -16,8 +16,11 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
 func ResourceIBMResourceKey() *schema.Resource {
@@ -75,6 +78,61 @@ func ResourceIBMResourceKey() *schema.Resource {
 				Description:      "Arbitrary parameters to pass. Must be a JSON object",
 			},
 
+			"secrets_manager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Stores the credentials of the key in a Secrets Manager secret instead of the Terraform state.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The ID of the Secrets Manager instance.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The region of the Secrets Manager instance. Defaults to the region of the provider.",
+						},
+						"secret_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "default",
+							Description: "The ID of the secret group of the secret.",
+						},
+						"secret_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the secret.",
+						},
+						"secret_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "arbitrary",
+							ValidateFunc: validate.InvokeValidator("ibm_resource_key", "secret_type"),
+							Description:  "The type of the secret, arbitrary or kv.",
+						},
+						"secret_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the secret that holds the credentials.",
+						},
+						"secret_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the secret that holds the credentials.",
+						},
+					},
+				},
+			},
+
 			"credentials": {
 				Description: "Credentials asociated with the key",
 				Type:        schema.TypeMap,
@@ -203,6 +261,13 @@ func ResourceIBMResourceKeyValidator() *validate.ResourceValidator {
 			CloudDataType:              "resource_instance",
 			CloudDataRange:             []string{"service:%s"},
 			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "secret_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "arbitrary, kv"})
 
 	ibmResourceKeyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_resource_key", Schema: validateSchema}
 	return &ibmResourceKeyResourceValidator
@@ -281,6 +346,19 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*resourceKey.ID)
 
+	if sm, ok := d.GetOk("secrets_manager"); ok {
+		secretsManager := sm.([]interface{})[0].(map[string]interface{})
+		secretID, secretCRN, err := createResourceKeySecret(secretsManager, resourceKey.Credentials, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error storing the credentials of resource key %s in Secrets Manager: %s", d.Id(), err)
+		}
+		secretsManager["secret_id"] = secretID
+		secretsManager["secret_crn"] = secretCRN
+		if err = d.Set("secrets_manager", []interface{}{secretsManager}); err != nil {
+			return fmt.Errorf("[ERROR] Error setting secrets_manager: %s", err)
+		}
+	}
+
 	return resourceIBMResourceKeyRead(d, meta)
 }
 
@@ -302,17 +380,34 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil || resourceKey == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp)
 	}
-	var credInterface map[string]interface{}
-	cred, _ := json.Marshal(resourceKey.Credentials)
-	json.Unmarshal(cred, &credInterface)
-	d.Set("credentials", flex.Flatten(credInterface))
+	// Credentials kept in Secrets Manager are not written to the state.
+	if sm, ok := d.GetOk("secrets_manager"); ok {
+		d.Set("credentials", nil)
+		d.Set("credentials_json", "")
 
-	creds, err := json.Marshal(resourceKey.Credentials)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
-	}
-	if err = d.Set("credentials_json", string(creds)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+		// A secret deleted outside of Terraform is cleared from the state, so
+		// that the next apply re-creates the key and stores its credentials again.
+		exists, err := resourceKeySecretExists(sm.([]interface{})[0].(map[string]interface{}), meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving the Secrets Manager secret of resource key %s: %s", resourceKeyID, err)
+		}
+		if !exists {
+			log.Printf("[WARN] The Secrets Manager secret of resource key %s was not found, removing secrets_manager from the state", resourceKeyID)
+			d.Set("secrets_manager", nil)
+		}
+	} else {
+		var credInterface map[string]interface{}
+		cred, _ := json.Marshal(resourceKey.Credentials)
+		json.Unmarshal(cred, &credInterface)
+		d.Set("credentials", flex.Flatten(credInterface))
+
+		creds, err := json.Marshal(resourceKey.Credentials)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+		}
+		if err = d.Set("credentials_json", string(creds)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+		}
 	}
 	d.Set("name", *resourceKey.Name)
 	d.Set("status", *resourceKey.State)
@@ -403,6 +498,14 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp)
 	}
 
+	if sm, ok := d.GetOk("secrets_manager"); ok {
+		secretsManager := sm.([]interface{})[0].(map[string]interface{})
+		err = deleteResourceKeySecret(secretsManager, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting the Secrets Manager secret of resource key %s: %s", resourceKeyID, err)
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -503,3 +606,115 @@ func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicym
 	return role, nil
 
 }
+
+// resourceKeySecretsManagerClient returns a Secrets Manager client for the
+// instance of the secrets_manager block.
+func resourceKeySecretsManagerClient(secretsManager map[string]interface{}, meta interface{}) (*secretsmanagerv2.SecretsManagerV2, error) {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, err
+	}
+	return secretsmanager.GetClientWithInstanceEndpoint(secretsManagerClient, secretsManager["instance_id"].(string), secretsManager["region"].(string), ""), nil
+}
+
+func createResourceKeySecret(secretsManager map[string]interface{}, credentials *rc.Credentials, meta interface{}) (string, string, error) {
+	secretsManagerClient, err := resourceKeySecretsManagerClient(secretsManager, meta)
+	if err != nil {
+		return "", "", err
+	}
+
+	creds, err := json.Marshal(credentials)
+	if err != nil {
+		return "", "", fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+	}
+
+	name := secretsManager["secret_name"].(string)
+	secretGroupID := secretsManager["secret_group_id"].(string)
+	description := "Credentials of an IBM Cloud resource key"
+	var secretPrototype secretsmanagerv2.SecretPrototypeIntf
+	if secretsManager["secret_type"].(string) == "kv" {
+		var data map[string]interface{}
+		if err = json.Unmarshal(creds, &data); err != nil {
+			return "", "", fmt.Errorf("[ERROR] Error unmarshalling resource key credentials: %s", err)
+		}
+		secretPrototype = &secretsmanagerv2.KVSecretPrototype{
+			SecretType:    core.StringPtr(secretsmanagerv2.Secret_SecretType_Kv),
+			Name:          &name,
+			Description:   &description,
+			SecretGroupID: &secretGroupID,
+			Data:          data,
+		}
+	} else {
+		secretPrototype = &secretsmanagerv2.ArbitrarySecretPrototype{
+			SecretType:    core.StringPtr(secretsmanagerv2.Secret_SecretType_Arbitrary),
+			Name:          &name,
+			Description:   &description,
+			SecretGroupID: &secretGroupID,
+			Payload:       core.StringPtr(string(creds)),
+		}
+	}
+
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{
+		SecretPrototype: secretPrototype,
+	}
+	secretIntf, resp, err := secretsManagerClient.CreateSecret(createSecretOptions)
+	if err != nil {
+		return "", "", fmt.Errorf("[ERROR] Error creating secret %s: %s with resp code: %s", name, err, resp)
+	}
+
+	switch secret := secretIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecret:
+		return *secret.ID, *secret.Crn, nil
+	case *secretsmanagerv2.KVSecret:
+		return *secret.ID, *secret.Crn, nil
+	}
+	return "", "", fmt.Errorf("[ERROR] Unexpected type of secret %s", name)
+}
+
+// resourceKeySecretExists reports whether the secret of the secrets_manager
+// block still exists.
+func resourceKeySecretExists(secretsManager map[string]interface{}, meta interface{}) (bool, error) {
+	secretID := secretsManager["secret_id"].(string)
+	if secretID == "" {
+		return false, nil
+	}
+	secretsManagerClient, err := resourceKeySecretsManagerClient(secretsManager, meta)
+	if err != nil {
+		return false, err
+	}
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{
+		ID: &secretID,
+	}
+	_, resp, err := secretsManagerClient.GetSecretMetadata(getSecretMetadataOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("[ERROR] Error getting secret %s: %s with resp code: %s", secretID, err, resp)
+	}
+	return true, nil
+}
+
+func deleteResourceKeySecret(secretsManager map[string]interface{}, meta interface{}) error {
+	secretID := secretsManager["secret_id"].(string)
+	if secretID == "" {
+		return nil
+	}
+	secretsManagerClient, err := resourceKeySecretsManagerClient(secretsManager, meta)
+	if err != nil {
+		return err
+	}
+
+	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{
+		ID: &secretID,
+	}
+	resp, err := secretsManagerClient.DeleteSecret(deleteSecretOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting secret %s: %s with resp code: %s", secretID, err, resp)
+	}
+	return nil
+}
@@ -47,6 +47,30 @@ func TestAccIBMResourceKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceKey_SecretsManager(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeySecretsManager(resourceName, resourceKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "name", resourceKey),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "credentials.%", "0"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "credentials_json", ""),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "secrets_manager.0.secret_id"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "secrets_manager.0.secret_crn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceKey_With_Tags(t *testing.T) {
 	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
@@ -197,6 +221,28 @@ func testAccCheckIBMResourceKeyBasic(resourceName, resourceKey string) string {
 	`, resourceName, resourceKey)
 }
 
+func testAccCheckIBMResourceKeySecretsManager(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "resource" {
+			name              = "%s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name = "%s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role = "Reader"
+			secrets_manager {
+				instance_id = "%s"
+				region      = "%s"
+				secret_name = "%s"
+				secret_type = "kv"
+			}
+		}
+	`, resourceName, resourceKey, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, resourceKey)
+}
+
 func testAccCheckIBMResourceKeyWithCustomRole(resourceName, resourceKey, crName, displayName string) string {
 	return fmt.Sprintf(`
 		
@@ -4,6 +4,8 @@
 package satellite
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -14,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,6 +55,18 @@ func DataSourceIBMSatelliteAttachHostScript() *schema.Resource {
 				Optional:     true,
 				ExactlyOneOf: []string{"host_provider", "custom_script"},
 			},
+			"output_format": {
+				Description:  "The format of the generated output. `script` writes the attach script to `script_dir`, `cloud_init` (RHEL hosts) and `ignition` (CoreOS hosts) return a cloud-init or Ignition document in `user_data` and only write it to a file when `script_dir` is set",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "script",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"script", "cloud_init", "ignition"}),
+			},
+			"user_data": {
+				Description: "The cloud-init or Ignition document that runs the attach host script, when output_format is cloud_init or ignition",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"script_dir": {
 				Description: "The directory where the satellite attach host script to be downloaded. Default is home directory",
 				Type:        schema.TypeString,
@@ -82,6 +97,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 	var scriptDir string
 	location := d.Get("location").(string)
 	hostProvider := d.Get("host_provider").(string)
+	outputFormat := d.Get("output_format").(string)
 
 	if _, ok := d.GetOk("script_dir"); ok {
 		scriptDir = d.Get("script_dir").(string)
@@ -124,13 +140,24 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 		d.Set("labels", l)
 	}
 
-	if len(scriptDir) == 0 {
+	if outputFormat == "cloud_init" && d.Get("coreos_host").(bool) {
+		return fmt.Errorf("[ERROR] output_format cloud_init is not supported for CoreOS hosts, use ignition")
+	}
+	if outputFormat == "ignition" && !d.Get("coreos_host").(bool) {
+		return fmt.Errorf("[ERROR] output_format ignition is only supported for CoreOS hosts, use cloud_init")
+	}
+
+	// The user data formats don't write to the local disk unless asked to,
+	// so that they also work on remote runners.
+	if len(scriptDir) == 0 && outputFormat == "script" {
 		scriptDir, err = homedir.Dir()
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error fetching homedir: %s", err)
 		}
 	}
-	scriptDir, _ = filepath.Abs(scriptDir)
+	if len(scriptDir) > 0 {
+		scriptDir, _ = filepath.Abs(scriptDir)
+	}
 	var scriptPath string
 
 	//Generate script
@@ -224,9 +251,29 @@ yum install container-selinux -y
 		scriptContent = strings.Join(lines, "\n")
 	}
 
-	err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
+	fileContent := scriptContent
+	switch outputFormat {
+	case "cloud_init":
+		fileContent = SatelliteHostScriptCloudInit(scriptContent)
+		scriptPath = filepath.Join(scriptDir, "addHost.yaml")
+	case "ignition":
+		fileContent, err = SatelliteHostScriptIgnition(scriptContent, d.Get("custom_script").(string))
+		if err != nil {
+			return err
+		}
+		scriptPath = filepath.Join(scriptDir, "addHost.ign")
+	}
+	if outputFormat != "script" {
+		d.Set("user_data", fileContent)
+	}
+
+	if len(scriptDir) > 0 {
+		err = ioutil.WriteFile(scriptPath, []byte(fileContent), 0644)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
+		}
+	} else {
+		scriptPath = ""
 	}
 
 	d.Set("location", location)
@@ -240,3 +287,94 @@ yum install container-selinux -y
 
 	return nil
 }
+
+const (
+	satelliteAttachScriptPath = "/usr/local/bin/ibm-satellite-attach-host.sh"
+	satelliteCustomScriptPath = "/usr/local/bin/ibm-satellite-custom-script.sh"
+)
+
+// SatelliteHostScriptCloudInit returns a cloud-init document that writes the
+// attach script to the host and runs it on the first boot. The script is base64
+// encoded so that it doesn't need YAML escaping.
+func SatelliteHostScriptCloudInit(script string) string {
+	var cloudConfig strings.Builder
+	cloudConfig.WriteString("#cloud-config\n")
+	cloudConfig.WriteString("write_files:\n")
+	cloudConfig.WriteString("  - path: " + satelliteAttachScriptPath + "\n")
+	cloudConfig.WriteString("    permissions: '0755'\n")
+	cloudConfig.WriteString("    owner: root:root\n")
+	cloudConfig.WriteString("    encoding: b64\n")
+	cloudConfig.WriteString("    content: " + base64.StdEncoding.EncodeToString([]byte(script)) + "\n")
+	cloudConfig.WriteString("runcmd:\n")
+	cloudConfig.WriteString("  - [ bash, " + satelliteAttachScriptPath + " ]\n")
+	return cloudConfig.String()
+}
+
+// SatelliteHostScriptIgnition returns the Ignition config of a CoreOS host. The
+// attach script of a CoreOS host is already an Ignition config, and the custom
+// script is added to it as a file with a oneshot systemd unit.
+func SatelliteHostScriptIgnition(script string, customScript string) (string, error) {
+	config := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(script), &config); err != nil {
+		return "", fmt.Errorf("[ERROR] Error parsing the Satellite Attach Host Ignition config: %s", err)
+	}
+	if customScript != "" {
+		SatelliteIgnitionAddScript(config, satelliteCustomScriptPath, "ibm-satellite-custom-script.service", customScript)
+	}
+
+	content, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error rendering the Satellite Attach Host Ignition config: %s", err)
+	}
+	return string(content), nil
+}
+
+func SatelliteIgnitionAddScript(config map[string]interface{}, path string, unitName string, script string) {
+	file := map[string]interface{}{
+		"path":      path,
+		"mode":      0755,
+		"overwrite": true,
+		"contents": map[string]interface{}{
+			"source": "data:text/plain;charset=utf-8;base64," + base64.StdEncoding.EncodeToString([]byte(script)),
+		},
+	}
+	// Ignition spec 2 configs need the filesystem of every file.
+	if ignition, ok := config["ignition"].(map[string]interface{}); ok {
+		if version, ok := ignition["version"].(string); ok && strings.HasPrefix(version, "2.") {
+			file["filesystem"] = "root"
+		}
+	}
+	unit := map[string]interface{}{
+		"name":    unitName,
+		"enabled": true,
+		"contents": fmt.Sprintf(`[Unit]
+Description=Runs %s
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/usr/bin/bash %s
+
+[Install]
+WantedBy=multi-user.target
+`, path, path),
+	}
+
+	storage, _ := config["storage"].(map[string]interface{})
+	if storage == nil {
+		storage = map[string]interface{}{}
+		config["storage"] = storage
+	}
+	files, _ := storage["files"].([]interface{})
+	storage["files"] = append(files, file)
+
+	systemd, _ := config["systemd"].(map[string]interface{})
+	if systemd == nil {
+		systemd = map[string]interface{}{}
+		config["systemd"] = systemd
+	}
+	units, _ := systemd["units"].([]interface{})
+	systemd["units"] = append(units, unit)
+}
@@ -4,13 +4,18 @@
 package satellite_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/satellite"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMSatelliteAttachHostScriptDataSourceBasic(t *testing.T) {
@@ -77,3 +82,167 @@ data "ibm_satellite_attach_host_script" "script" {
 	host_provider  = "ibm"
 }`, locationName)
 }
+
+func TestAccIBMSatelliteAttachHostScriptDataSourceCloudInit(t *testing.T) {
+	locationName := fmt.Sprintf("tf-satellitelocation-cloudinit-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteAttachHostScriptDataSourceConfigOutputFormat(locationName, false, "cloud_init"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.ibm_satellite_attach_host_script.script", "user_data", regexp.MustCompile(`^#cloud-config\n`)),
+					resource.TestCheckResourceAttr("data.ibm_satellite_attach_host_script.script", "script_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSatelliteAttachHostScriptDataSourceIgnition(t *testing.T) {
+	locationName := fmt.Sprintf("tf-satellitelocation-ignition-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteAttachHostScriptDataSourceConfigOutputFormat(locationName, true, "ignition"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.ibm_satellite_attach_host_script.script", "user_data", regexp.MustCompile(`"ignition":\{"version":`)),
+					resource.TestCheckResourceAttr("data.ibm_satellite_attach_host_script.script", "script_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteAttachHostScriptDataSourceConfigOutputFormat(locationName string, coreos bool, outputFormat string) string {
+	return fmt.Sprintf(`
+resource "ibm_satellite_location" "testacc_satellite" {
+	location     = "%s"
+	managed_from = "wdc04"
+	coreos_enabled = %t
+	zones		 = ["us-east-1", "us-east-2", "us-east-3"]
+}
+
+data "ibm_satellite_attach_host_script" "script" {
+	location       = ibm_satellite_location.testacc_satellite.id
+	labels         = ["env:prod"]
+	coreos_host	   = %t
+	host_provider  = "ibm"
+	output_format  = "%s"
+}`, locationName, coreos, coreos, outputFormat)
+}
+
+func TestSatelliteHostScriptCloudInit(t *testing.T) {
+	script := "#!/usr/bin/env bash\necho 'attach' \"$HOST\"\n"
+
+	expected := "#cloud-config\n" +
+		"write_files:\n" +
+		"  - path: /usr/local/bin/ibm-satellite-attach-host.sh\n" +
+		"    permissions: '0755'\n" +
+		"    owner: root:root\n" +
+		"    encoding: b64\n" +
+		"    content: " + base64.StdEncoding.EncodeToString([]byte(script)) + "\n" +
+		"runcmd:\n" +
+		"  - [ bash, /usr/local/bin/ibm-satellite-attach-host.sh ]\n"
+	assert.Equal(t, expected, satellite.SatelliteHostScriptCloudInit(script))
+}
+
+// testSatelliteIgnitionNames returns the given key of each item of an
+// Ignition config section, such as the paths of the storage files.
+func testSatelliteIgnitionNames(config map[string]interface{}, section, items, key string) []string {
+	names := []string{}
+	sectionMap, _ := config[section].(map[string]interface{})
+	list, _ := sectionMap[items].([]interface{})
+	for _, item := range list {
+		names = append(names, item.(map[string]interface{})[key].(string))
+	}
+	return names
+}
+
+func TestSatelliteHostScriptIgnition(t *testing.T) {
+	coreosConfig := `{
+		"ignition": {"version": "3.3.0", "config": {"merge": [{"source": "https://location.example.com/ignition"}]}},
+		"storage": {"files": [{"path": "/etc/hostname", "contents": {"source": "data:,host-1"}}]},
+		"systemd": {"units": [{"name": "attach.service", "enabled": true}]},
+		"passwd": {"users": [{"name": "core"}]}
+	}`
+
+	testcases := []struct {
+		customScript string
+		files        []string
+		units        []string
+	}{
+		{
+			customScript: "#!/usr/bin/env bash\necho custom\n",
+			files:        []string{"/etc/hostname", "/usr/local/bin/ibm-satellite-custom-script.sh"},
+			units:        []string{"attach.service", "ibm-satellite-custom-script.service"},
+		},
+		{
+			files: []string{"/etc/hostname"},
+			units: []string{"attach.service"},
+		},
+	}
+
+	for _, c := range testcases {
+		content, err := satellite.SatelliteHostScriptIgnition(coreosConfig, c.customScript)
+		assert.NilError(t, err)
+		config := map[string]interface{}{}
+		assert.NilError(t, json.Unmarshal([]byte(content), &config))
+
+		// The rest of the location config is kept.
+		ignition := config["ignition"].(map[string]interface{})
+		assert.Equal(t, "3.3.0", ignition["version"])
+		assert.Assert(t, ignition["config"] != nil)
+		assert.Assert(t, config["passwd"] != nil)
+		assert.DeepEqual(t, c.files, testSatelliteIgnitionNames(config, "storage", "files", "path"))
+		assert.DeepEqual(t, c.units, testSatelliteIgnitionNames(config, "systemd", "units", "name"))
+	}
+
+	_, err := satellite.SatelliteHostScriptIgnition("#!/usr/bin/env bash", "")
+	assert.Assert(t, err != nil)
+}
+
+func TestSatelliteIgnitionAddScript(t *testing.T) {
+	script := "#!/usr/bin/env bash\necho custom\n"
+	path := "/usr/local/bin/ibm-satellite-custom-script.sh"
+
+	testcases := []struct {
+		version    string
+		filesystem interface{}
+	}{
+		{
+			version:    "3.3.0",
+			filesystem: nil,
+		},
+		{
+			version:    "2.2.0",
+			filesystem: "root",
+		},
+	}
+
+	for _, c := range testcases {
+		config := map[string]interface{}{
+			"ignition": map[string]interface{}{"version": c.version},
+		}
+		satellite.SatelliteIgnitionAddScript(config, path, "ibm-satellite-custom-script.service", script)
+		content, err := json.Marshal(config)
+		assert.NilError(t, err)
+		config = map[string]interface{}{}
+		assert.NilError(t, json.Unmarshal(content, &config))
+		assert.DeepEqual(t, []string{path}, testSatelliteIgnitionNames(config, "storage", "files", "path"))
+		assert.DeepEqual(t, []string{"ibm-satellite-custom-script.service"}, testSatelliteIgnitionNames(config, "systemd", "units", "name"))
+
+		file := config["storage"].(map[string]interface{})["files"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, float64(0755), file["mode"])
+		assert.Equal(t, true, file["overwrite"])
+		assert.Equal(t, "data:text/plain;charset=utf-8;base64,"+base64.StdEncoding.EncodeToString([]byte(script)), file["contents"].(map[string]interface{})["source"])
+		assert.Equal(t, c.filesystem, file["filesystem"])
+
+		unit := config["systemd"].(map[string]interface{})["units"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, true, unit["enabled"])
+		assert.Assert(t, regexp.MustCompile(`ExecStart=/usr/bin/bash `+regexp.QuoteMeta(path)).MatchString(unit["contents"].(string)))
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSatelliteStorageAssignment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSatelliteStorageAssignmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"uuid", "assignment_name"},
+				Description:  "The universally unique identifier of the storage assignment.",
+			},
+			"assignment_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"uuid", "assignment_name"},
+				Description:  "The name of the storage assignment.",
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the assigned storage configuration.",
+			},
+			"config_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier of the storage configuration.",
+			},
+			"config_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the storage configuration rolled out by the assignment.",
+			},
+			"config_version_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier of the version of the storage configuration.",
+			},
+			"cluster": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the Satellite cluster the storage configuration is assigned to.",
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Satellite cluster groups the storage configuration is assigned to.",
+			},
+			"assignment_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the storage assignment.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the storage assignment was created.",
+			},
+			"rollout_success_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of clusters the storage configuration was rolled out to.",
+			},
+			"rollout_error_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of clusters the storage configuration failed to roll out to.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSatelliteStorageAssignmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var assignment *kubernetesserviceapiv1.Subscription
+	if uuid, ok := d.GetOk("uuid"); ok {
+		getAssignmentOptions := &kubernetesserviceapiv1.GetAssignmentOptions{}
+		getAssignmentOptions.SetUUID(uuid.(string))
+		result, response, err := satClient.GetAssignmentWithContext(context, getAssignmentOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetAssignmentWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response))
+		}
+		assignment = result
+	} else {
+		getAssignmentByNameOptions := &kubernetesserviceapiv1.GetAssignmentByNameOptions{}
+		getAssignmentByNameOptions.SetName(d.Get("assignment_name").(string))
+		result, response, err := satClient.GetAssignmentByNameWithContext(context, getAssignmentByNameOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetAssignmentByNameWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetAssignmentByNameWithContext failed %s\n%s", err, response))
+		}
+		assignment = result
+	}
+	if assignment == nil || assignment.UUID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Storage assignment not found"))
+	}
+
+	d.SetId(*assignment.UUID)
+	d.Set("uuid", assignment.UUID)
+	d.Set("assignment_name", assignment.Name)
+	d.Set("config", assignment.ChannelName)
+	d.Set("config_uuid", assignment.ChannelUUID)
+	d.Set("config_version", assignment.Version)
+	d.Set("config_version_uuid", assignment.VersionUUID)
+	d.Set("cluster", assignment.Cluster)
+	d.Set("groups", assignment.Groups)
+	d.Set("assignment_type", assignment.SubscriptionType)
+	d.Set("created", assignment.Created)
+	if assignment.RolloutStatus != nil {
+		d.Set("rollout_success_count", flex.IntValue(assignment.RolloutStatus.SuccessCount))
+		d.Set("rollout_error_count", flex.IntValue(assignment.RolloutStatus.ErrorCount))
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmSatelliteStorageAssignmentDataSourceBasic(t *testing.T) {
+	configName := fmt.Sprintf("tf-storage-config-%d", acctest.RandIntRange(10, 100))
+	assignmentName := fmt.Sprintf("tf-storage-assignment-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSatelliteStorageAssignmentDataSourceConfig(configName, assignmentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_storage_assignment.storage_assignment", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_storage_assignment.storage_assignment", "uuid"),
+					resource.TestCheckResourceAttr("data.ibm_satellite_storage_assignment.storage_assignment", "config", configName),
+					resource.TestCheckResourceAttr("data.ibm_satellite_storage_assignment.storage_assignment", "cluster", acc.Satellite_cluster_id),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_storage_assignment.storage_assignment", "rollout_success_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteStorageAssignmentDataSourceConfig(configName, assignmentName string) string {
+	return testAccCheckIbmSatelliteStorageAssignmentConfig(configName, assignmentName) + `
+		data "ibm_satellite_storage_assignment" "storage_assignment" {
+			assignment_name = ibm_satellite_storage_assignment.storage_assignment.assignment_name
+		}
+	`
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSatelliteStorageConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSatelliteStorageConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"config_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the storage configuration.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Location ID.",
+			},
+			"config_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the storage configuration.",
+			},
+			"storage_template_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The storage template the configuration is created from.",
+			},
+			"storage_template_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the storage template.",
+			},
+			"user_config_parameters": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The custom parameters of the storage template.",
+			},
+			"storage_class_parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The custom storage classes of the configuration, each as a map of storage class parameters.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier of the storage configuration.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSatelliteStorageConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{}
+	getStorageConfigurationOptions.SetName(d.Get("config_name").(string))
+
+	storageConfig, response, err := satClient.GetStorageConfigurationWithContext(context, getStorageConfigurationOptions)
+	if err != nil || storageConfig == nil || storageConfig.UUID == nil {
+		log.Printf("[DEBUG] GetStorageConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetStorageConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*storageConfig.UUID)
+	d.Set("location", storageConfig.Location)
+	d.Set("config_version", storageConfig.ConfigVersion)
+	d.Set("storage_template_name", storageConfig.StorageTemplateName)
+	d.Set("storage_template_version", storageConfig.StorageTemplateVersion)
+	d.Set("user_config_parameters", storageConfig.UserConfigParameters)
+	d.Set("storage_class_parameters", flattenSatelliteStorageClassParameters(storageConfig.StorageClassParameters))
+	d.Set("uuid", storageConfig.UUID)
+
+	return nil
+}
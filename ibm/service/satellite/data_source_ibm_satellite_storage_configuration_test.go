@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmSatelliteStorageConfigurationDataSourceBasic(t *testing.T) {
+	configName := fmt.Sprintf("tf-storage-config-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSatelliteStorageConfigurationDataSourceConfig(configName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_storage_configuration.storage_configuration", "id"),
+					resource.TestCheckResourceAttr("data.ibm_satellite_storage_configuration.storage_configuration", "config_name", configName),
+					resource.TestCheckResourceAttr("data.ibm_satellite_storage_configuration.storage_configuration", "storage_template_name", "local-volume-block"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_storage_configuration.storage_configuration", "config_version"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_storage_configuration.storage_configuration", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteStorageConfigurationDataSourceConfig(configName string) string {
+	return testAccCheckIbmSatelliteStorageConfigurationConfig(configName, "/dev/sdc") + `
+		data "ibm_satellite_storage_configuration" "storage_configuration" {
+			config_name = ibm_satellite_storage_configuration.storage_configuration.config_name
+		}
+	`
+}
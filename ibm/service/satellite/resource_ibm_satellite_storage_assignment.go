@@ -0,0 +1,396 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isStorageAssignmentRollingOut = "rolling_out"
+	isStorageAssignmentDeployed   = "deployed"
+	isStorageAssignmentFailed     = "failed"
+)
+
+func ResourceIBMSatelliteStorageAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSatelliteStorageAssignmentCreate,
+		ReadContext:   resourceIbmSatelliteStorageAssignmentRead,
+		UpdateContext: resourceIbmSatelliteStorageAssignmentUpdate,
+		DeleteContext: resourceIbmSatelliteStorageAssignmentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			// A new revision of the configuration is rolled out by the next apply
+			// when the assignment follows the revisions of its configuration.
+			if diff.Id() != "" && diff.Get("update_config_revision").(bool) && diff.Get("is_assignment_upgrade_available").(bool) {
+				return diff.SetNewComputed("config_version")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assignment_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the storage assignment.",
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the storage configuration to assign.",
+			},
+			"cluster": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cluster", "groups"},
+				Description:  "The ID of the Satellite cluster to assign the storage configuration to.",
+			},
+			"groups": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"cluster", "groups"},
+				Description:  "The Satellite cluster groups to assign the storage configuration to.",
+			},
+			"update_config_revision": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to roll out the latest revision of the storage configuration to the assignment.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier of the storage assignment.",
+			},
+			"config_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier of the storage configuration.",
+			},
+			"config_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the storage configuration rolled out by the assignment.",
+			},
+			"config_version_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier of the version of the storage configuration.",
+			},
+			"is_assignment_upgrade_available": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a newer revision of the storage configuration is available to the assignment.",
+			},
+			"assignment_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the storage assignment.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the storage assignment was created.",
+			},
+			"rollout_success_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of clusters the storage configuration was rolled out to.",
+			},
+			"rollout_error_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of clusters the storage configuration failed to roll out to.",
+			},
+		},
+	}
+}
+
+func resourceIbmSatelliteStorageAssignmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config := d.Get("config").(string)
+	getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{}
+	getStorageConfigurationOptions.SetName(config)
+	storageConfig, response, err := satClient.GetStorageConfigurationWithContext(context, getStorageConfigurationOptions)
+	if err != nil || storageConfig == nil {
+		log.Printf("[DEBUG] GetStorageConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetStorageConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	createAssignmentOptions := &kubernetesserviceapiv1.CreateAssignmentOptions{}
+	createAssignmentOptions.SetName(d.Get("assignment_name").(string))
+	createAssignmentOptions.SetChannelName(config)
+	if storageConfig.ConfigVersion != nil {
+		createAssignmentOptions.SetVersion(*storageConfig.ConfigVersion)
+	}
+	if v, ok := d.GetOk("cluster"); ok {
+		createAssignmentOptions.SetCluster(v.(string))
+	}
+	if v, ok := d.GetOk("groups"); ok {
+		createAssignmentOptions.SetGroups(flex.ExpandStringList(v.([]interface{})))
+	}
+
+	subscription, response, err := satClient.CreateAssignmentWithContext(context, createAssignmentOptions)
+	if err != nil || subscription == nil || subscription.AddSubscription == nil {
+		log.Printf("[DEBUG] CreateAssignmentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateAssignmentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*subscription.AddSubscription.UUID)
+
+	_, err = waitForStorageAssignmentRollout(d.Id(), core.StringNilMapper(storageConfig.ConfigVersion), d.Timeout(schema.TimeoutCreate), meta)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for storage assignment (%s) to be rolled out: %s", d.Id(), err))
+	}
+
+	return resourceIbmSatelliteStorageAssignmentRead(context, d, meta)
+}
+
+func resourceIbmSatelliteStorageAssignmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getAssignmentOptions := &kubernetesserviceapiv1.GetAssignmentOptions{}
+	getAssignmentOptions.SetUUID(d.Id())
+
+	assignment, response, err := satClient.GetAssignmentWithContext(context, getAssignmentOptions)
+	if err != nil || assignment == nil || assignment.UUID == nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAssignmentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("assignment_name", assignment.Name)
+	d.Set("config", assignment.ChannelName)
+	d.Set("cluster", assignment.Cluster)
+	if len(assignment.Groups) > 0 {
+		d.Set("groups", assignment.Groups)
+	}
+	d.Set("uuid", assignment.UUID)
+	d.Set("config_uuid", assignment.ChannelUUID)
+	d.Set("config_version", assignment.Version)
+	d.Set("config_version_uuid", assignment.VersionUUID)
+	d.Set("assignment_type", assignment.SubscriptionType)
+	d.Set("created", assignment.Created)
+	if assignment.RolloutStatus != nil {
+		d.Set("rollout_success_count", flex.IntValue(assignment.RolloutStatus.SuccessCount))
+		d.Set("rollout_error_count", flex.IntValue(assignment.RolloutStatus.ErrorCount))
+	}
+
+	// The configuration is looked up for its latest revision; a missing
+	// configuration only means no upgrade is available.
+	upgradeAvailable := false
+	if assignment.ChannelName != nil {
+		getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{}
+		getStorageConfigurationOptions.SetName(*assignment.ChannelName)
+		storageConfig, response, err := satClient.GetStorageConfigurationWithContext(context, getStorageConfigurationOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] GetStorageConfigurationWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetStorageConfigurationWithContext failed %s\n%s", err, response))
+		}
+		if err == nil && storageConfig != nil && storageConfig.ConfigVersion != nil && assignment.Version != nil {
+			upgradeAvailable = *storageConfig.ConfigVersion != *assignment.Version
+		}
+	}
+	d.Set("is_assignment_upgrade_available", upgradeAvailable)
+
+	return nil
+}
+
+func resourceIbmSatelliteStorageAssignmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateConfigRevision := d.Get("update_config_revision").(bool) && d.Get("is_assignment_upgrade_available").(bool)
+	if d.HasChanges("assignment_name", "groups") || updateConfigRevision {
+		// The SDK does not expose updateConfigRevision yet, so the assignment is
+		// updated with the same request body the SDK sends plus that flag.
+		body := map[string]interface{}{
+			"uuid": d.Id(),
+			"name": d.Get("assignment_name").(string),
+		}
+		if v, ok := d.GetOk("groups"); ok {
+			body["groups"] = flex.ExpandStringList(v.([]interface{}))
+		}
+		if updateConfigRevision {
+			body["updateConfigRevision"] = true
+		}
+
+		// The assignment is expected to roll out the latest revision when it is
+		// upgraded, and the revision it already has otherwise.
+		expectedVersion := d.Get("config_version").(string)
+		if updateConfigRevision {
+			getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{}
+			getStorageConfigurationOptions.SetName(d.Get("config").(string))
+			storageConfig, response, err := satClient.GetStorageConfigurationWithContext(context, getStorageConfigurationOptions)
+			if err != nil || storageConfig == nil {
+				log.Printf("[DEBUG] GetStorageConfigurationWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("GetStorageConfigurationWithContext failed %s\n%s", err, response))
+			}
+			expectedVersion = core.StringNilMapper(storageConfig.ConfigVersion)
+		}
+
+		response, err := updateSatelliteStorageAssignment(context, satClient, body)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateAssignmentWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response))
+		}
+
+		_, err = waitForStorageAssignmentRollout(d.Id(), expectedVersion, d.Timeout(schema.TimeoutUpdate), meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for storage assignment (%s) to be rolled out: %s", d.Id(), err))
+		}
+	}
+
+	return resourceIbmSatelliteStorageAssignmentRead(context, d, meta)
+}
+
+func resourceIbmSatelliteStorageAssignmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	removeAssignmentOptions := &kubernetesserviceapiv1.RemoveAssignmentOptions{}
+	removeAssignmentOptions.SetUUID(d.Id())
+
+	_, response, err := satClient.RemoveAssignmentWithContext(context, removeAssignmentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] RemoveAssignmentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("RemoveAssignmentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func updateSatelliteStorageAssignment(context context.Context, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, body map[string]interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(core.PATCH)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = satClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(satClient.Service.Options.URL, `/v2/storage/satellite/updateAssignment`, nil)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	return satClient.Service.Request(request, &result)
+}
+
+// waitForStorageAssignmentRollout waits until the expected revision of the
+// storage configuration is rolled out to every cluster of the assignment, and
+// fails as soon as a cluster reports an error.
+func waitForStorageAssignmentRollout(uuid string, expectedVersion string, timeout time.Duration, meta interface{}) (interface{}, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return false, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isStorageAssignmentRollingOut},
+		Target:  []string{isStorageAssignmentDeployed},
+		Refresh: func() (interface{}, string, error) {
+			getAssignmentOptions := &kubernetesserviceapiv1.GetAssignmentOptions{}
+			getAssignmentOptions.SetUUID(uuid)
+			assignment, response, err := satClient.GetAssignment(getAssignmentOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Getting storage assignment : %s\n%s", err, response)
+			}
+			state := StorageAssignmentRolloutState(assignment, expectedVersion)
+			if state == isStorageAssignmentFailed {
+				return assignment, state, fmt.Errorf("[ERROR] The storage assignment failed to roll out to %d cluster(s) : %s", flex.IntValue(assignment.RolloutStatus.ErrorCount), uuid)
+			}
+			return assignment, state, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// StorageAssignmentRolloutState returns whether the assignment has rolled out
+// the expected revision to all of its clusters. The rollout counts of the
+// previous revision are still reported right after an update, so the revision
+// is checked before the counts. A cluster assignment targets one cluster. The
+// API does not report how many clusters a group holds, so a group assignment
+// keeps rolling out until its clusters report resources and every reporting
+// cluster has succeeded.
+func StorageAssignmentRolloutState(assignment *kubernetesserviceapiv1.Subscription, expectedVersion string) string {
+	if assignment == nil || assignment.RolloutStatus == nil {
+		return isStorageAssignmentRollingOut
+	}
+	if flex.IntValue(assignment.RolloutStatus.ErrorCount) > 0 {
+		return isStorageAssignmentFailed
+	}
+	if expectedVersion != "" && core.StringNilMapper(assignment.Version) != expectedVersion {
+		return isStorageAssignmentRollingOut
+	}
+
+	targetClusters := 1
+	if len(assignment.Groups) > 0 {
+		clusters := map[string]bool{}
+		for _, remoteResource := range assignment.RemoteResources {
+			if remoteResource.Cluster != nil && remoteResource.Cluster.ClusterID != nil {
+				clusters[*remoteResource.Cluster.ClusterID] = true
+			}
+		}
+		if len(clusters) == 0 {
+			return isStorageAssignmentRollingOut
+		}
+		targetClusters = len(clusters)
+	}
+	if flex.IntValue(assignment.RolloutStatus.SuccessCount) >= targetClusters {
+		return isStorageAssignmentDeployed
+	}
+	return isStorageAssignmentRollingOut
+}
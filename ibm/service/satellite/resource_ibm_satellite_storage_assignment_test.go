@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/satellite"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIbmSatelliteStorageAssignmentBasic(t *testing.T) {
+	configName := fmt.Sprintf("tf-storage-config-%d", acctest.RandIntRange(10, 100))
+	assignmentName := fmt.Sprintf("tf-storage-assignment-%d", acctest.RandIntRange(10, 100))
+	assignmentNameUpdate := fmt.Sprintf("tf-storage-assignment-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSatelliteStorageAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSatelliteStorageAssignmentConfig(configName, assignmentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSatelliteStorageAssignmentExists("ibm_satellite_storage_assignment.storage_assignment"),
+					resource.TestCheckResourceAttr("ibm_satellite_storage_assignment.storage_assignment", "assignment_name", assignmentName),
+					resource.TestCheckResourceAttr("ibm_satellite_storage_assignment.storage_assignment", "config", configName),
+					resource.TestCheckResourceAttr("ibm_satellite_storage_assignment.storage_assignment", "cluster", acc.Satellite_cluster_id),
+					resource.TestCheckResourceAttr("ibm_satellite_storage_assignment.storage_assignment", "rollout_error_count", "0"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_storage_assignment.storage_assignment", "config_version"),
+				),
+			},
+			{
+				Config: testAccCheckIbmSatelliteStorageAssignmentConfig(configName, assignmentNameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_storage_assignment.storage_assignment", "assignment_name", assignmentNameUpdate),
+				),
+			},
+			{
+				ResourceName:            "ibm_satellite_storage_assignment.storage_assignment",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_config_revision"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteStorageAssignmentConfig(configName, assignmentName string) string {
+	return testAccCheckIbmSatelliteStorageConfigurationConfig(configName, "/dev/sdc") + fmt.Sprintf(`
+		resource "ibm_satellite_storage_assignment" "storage_assignment" {
+			assignment_name        = "%s"
+			config                 = ibm_satellite_storage_configuration.storage_configuration.config_name
+			cluster                = "%s"
+			update_config_revision = true
+		}
+	`, assignmentName, acc.Satellite_cluster_id)
+}
+
+func testAccCheckIbmSatelliteStorageAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		satClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SatelliteClientSession()
+		if err != nil {
+			return err
+		}
+
+		getAssignmentOptions := &kubernetesserviceapiv1.GetAssignmentOptions{}
+		getAssignmentOptions.SetUUID(rs.Primary.ID)
+
+		_, _, err = satClient.GetAssignment(getAssignmentOptions)
+		return err
+	}
+}
+
+func testAccCheckIbmSatelliteStorageAssignmentDestroy(s *terraform.State) error {
+	satClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_satellite_storage_assignment" {
+			continue
+		}
+
+		getAssignmentOptions := &kubernetesserviceapiv1.GetAssignmentOptions{}
+		getAssignmentOptions.SetUUID(rs.Primary.ID)
+
+		assignment, response, err := satClient.GetAssignment(getAssignmentOptions)
+		if err == nil && assignment != nil && assignment.UUID != nil {
+			return fmt.Errorf("satellite_storage_assignment still exists: %s", rs.Primary.ID)
+		} else if err != nil && response != nil && response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for satellite_storage_assignment (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return testAccCheckIbmSatelliteStorageConfigurationDestroy(s)
+}
+
+func TestStorageAssignmentRolloutState(t *testing.T) {
+	onClusters := func(ids ...string) []kubernetesserviceapiv1.RemoteResources {
+		var remoteResources []kubernetesserviceapiv1.RemoteResources
+		for _, id := range ids {
+			remoteResources = append(remoteResources, kubernetesserviceapiv1.RemoteResources{
+				Cluster: &kubernetesserviceapiv1.RemoteResourcesCluster{ClusterID: core.StringPtr(id)},
+			})
+		}
+		return remoteResources
+	}
+
+	testcases := []struct {
+		assignment *kubernetesserviceapiv1.Subscription
+		version    string
+		expected   string
+	}{
+		{
+			expected: "rolling_out",
+		},
+		{
+			assignment: &kubernetesserviceapiv1.Subscription{
+				Version:       core.StringPtr("2"),
+				RolloutStatus: &kubernetesserviceapiv1.SubscriptionRolloutStatus{SuccessCount: core.Int64Ptr(1), ErrorCount: core.Int64Ptr(0)},
+			},
+			version:  "2",
+			expected: "deployed",
+		},
+		{
+			assignment: &kubernetesserviceapiv1.Subscription{
+				Version:       core.StringPtr("1"),
+				RolloutStatus: &kubernetesserviceapiv1.SubscriptionRolloutStatus{SuccessCount: core.Int64Ptr(1), ErrorCount: core.Int64Ptr(0)},
+			},
+			version:  "2",
+			expected: "rolling_out",
+		},
+		{
+			assignment: &kubernetesserviceapiv1.Subscription{
+				Version:       core.StringPtr("2"),
+				RolloutStatus: &kubernetesserviceapiv1.SubscriptionRolloutStatus{SuccessCount: core.Int64Ptr(1), ErrorCount: core.Int64Ptr(1)},
+			},
+			version:  "2",
+			expected: "failed",
+		},
+		{
+			assignment: &kubernetesserviceapiv1.Subscription{
+				Version:       core.StringPtr("2"),
+				Groups:        []string{"group"},
+				RolloutStatus: &kubernetesserviceapiv1.SubscriptionRolloutStatus{SuccessCount: core.Int64Ptr(1), ErrorCount: core.Int64Ptr(0)},
+			},
+			version:  "2",
+			expected: "rolling_out",
+		},
+		{
+			assignment: &kubernetesserviceapiv1.Subscription{
+				Version:         core.StringPtr("2"),
+				Groups:          []string{"group"},
+				RemoteResources: onClusters("a", "b", "c", "a"),
+				RolloutStatus:   &kubernetesserviceapiv1.SubscriptionRolloutStatus{SuccessCount: core.Int64Ptr(1), ErrorCount: core.Int64Ptr(0)},
+			},
+			version:  "2",
+			expected: "rolling_out",
+		},
+		{
+			assignment: &kubernetesserviceapiv1.Subscription{
+				Version:         core.StringPtr("2"),
+				Groups:          []string{"group"},
+				RemoteResources: onClusters("a", "b", "c"),
+				RolloutStatus:   &kubernetesserviceapiv1.SubscriptionRolloutStatus{SuccessCount: core.Int64Ptr(3), ErrorCount: core.Int64Ptr(0)},
+			},
+			version:  "2",
+			expected: "deployed",
+		},
+	}
+
+	for _, c := range testcases {
+		assert.Equal(t, c.expected, satellite.StorageAssignmentRolloutState(c.assignment, c.version))
+	}
+}
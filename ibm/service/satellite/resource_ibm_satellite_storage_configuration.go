@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSatelliteStorageConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSatelliteStorageConfigurationCreate,
+		ReadContext:   resourceIbmSatelliteStorageConfigurationRead,
+		UpdateContext: resourceIbmSatelliteStorageConfigurationUpdate,
+		DeleteContext: resourceIbmSatelliteStorageConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Location ID.",
+			},
+			"config_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the storage configuration.",
+			},
+			"config_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version of the storage configuration.",
+			},
+			"storage_template_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The storage template to create the configuration from, such as odf-remote or local-volume-block.",
+			},
+			"storage_template_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The version of the storage template.",
+			},
+			"user_config_parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The custom parameters of the storage template.",
+			},
+			"user_secret_parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The secret parameters of the storage template, such as credentials.",
+			},
+			"storage_class_parameters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The custom storage classes to create, each as a map of storage class parameters.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier of the storage configuration.",
+			},
+		},
+	}
+}
+
+func resourceIbmSatelliteStorageConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	location := d.Get("location").(string)
+	configName := d.Get("config_name").(string)
+
+	err = validateSatelliteStorageTemplateParameters(context, satClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createStorageConfigurationOptions := &kubernetesserviceapiv1.CreateStorageConfigurationOptions{}
+	createStorageConfigurationOptions.SetLocation(location)
+	createStorageConfigurationOptions.SetConfigName(configName)
+	createStorageConfigurationOptions.SetStorageTemplateName(d.Get("storage_template_name").(string))
+	createStorageConfigurationOptions.SetStorageTemplateVersion(d.Get("storage_template_version").(string))
+	if v, ok := d.GetOk("config_version"); ok {
+		createStorageConfigurationOptions.SetConfigVersion(v.(string))
+	}
+	createStorageConfigurationOptions.UserConfigParameters = expandSatelliteStringMap(d.Get("user_config_parameters").(map[string]interface{}))
+	createStorageConfigurationOptions.UserSecretParameters = expandSatelliteStringMap(d.Get("user_secret_parameters").(map[string]interface{}))
+	createStorageConfigurationOptions.StorageClassParameters = expandSatelliteStorageClassParameters(d.Get("storage_class_parameters").([]interface{}))
+
+	_, response, err := satClient.CreateStorageConfigurationWithContext(context, createStorageConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateStorageConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateStorageConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", location, configName))
+
+	return resourceIbmSatelliteStorageConfigurationRead(context, d, meta)
+}
+
+func resourceIbmSatelliteStorageConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of location/config_name", d.Id()))
+	}
+
+	getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{}
+	getStorageConfigurationOptions.SetName(parts[1])
+
+	storageConfig, response, err := satClient.GetStorageConfigurationWithContext(context, getStorageConfigurationOptions)
+	if err != nil || storageConfig == nil || storageConfig.UUID == nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetStorageConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetStorageConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("location", parts[0])
+	d.Set("config_name", storageConfig.ConfigName)
+	d.Set("config_version", storageConfig.ConfigVersion)
+	d.Set("storage_template_name", storageConfig.StorageTemplateName)
+	d.Set("storage_template_version", storageConfig.StorageTemplateVersion)
+	d.Set("user_config_parameters", storageConfig.UserConfigParameters)
+	d.Set("storage_class_parameters", flattenSatelliteStorageClassParameters(storageConfig.StorageClassParameters))
+	d.Set("uuid", storageConfig.UUID)
+	// The values of the secret parameters are not returned, so only the
+	// configured keys the API no longer knows about are dropped.
+	if secrets, ok := d.GetOk("user_secret_parameters"); ok && storageConfig.UserSecretParameters != nil {
+		userSecretParameters := map[string]interface{}{}
+		for k, v := range secrets.(map[string]interface{}) {
+			if _, ok := storageConfig.UserSecretParameters[k]; ok {
+				userSecretParameters[k] = v
+			}
+		}
+		d.Set("user_secret_parameters", userSecretParameters)
+	}
+
+	return nil
+}
+
+func resourceIbmSatelliteStorageConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("config_version", "storage_template_version", "user_config_parameters", "user_secret_parameters", "storage_class_parameters") {
+		err = validateSatelliteStorageTemplateParameters(context, satClient, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		updateStorageConfigurationOptions := &kubernetesserviceapiv1.UpdateStorageConfigurationOptions{}
+		updateStorageConfigurationOptions.SetLocation(d.Get("location").(string))
+		updateStorageConfigurationOptions.SetConfigName(d.Get("config_name").(string))
+		updateStorageConfigurationOptions.SetUUID(d.Get("uuid").(string))
+		updateStorageConfigurationOptions.SetStorageTemplateName(d.Get("storage_template_name").(string))
+		updateStorageConfigurationOptions.SetStorageTemplateVersion(d.Get("storage_template_version").(string))
+		if d.HasChange("config_version") {
+			updateStorageConfigurationOptions.SetConfigVersion(d.Get("config_version").(string))
+		}
+		updateStorageConfigurationOptions.UserConfigParameters = expandSatelliteStringMap(d.Get("user_config_parameters").(map[string]interface{}))
+		updateStorageConfigurationOptions.UserSecretParameters = expandSatelliteStringMap(d.Get("user_secret_parameters").(map[string]interface{}))
+		updateStorageConfigurationOptions.StorageClassParameters = expandSatelliteStorageClassParameters(d.Get("storage_class_parameters").([]interface{}))
+
+		_, response, err := satClient.UpdateStorageConfigurationWithContext(context, updateStorageConfigurationOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateStorageConfigurationWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateStorageConfigurationWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmSatelliteStorageConfigurationRead(context, d, meta)
+}
+
+func resourceIbmSatelliteStorageConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	removeStorageConfigurationOptions := &kubernetesserviceapiv1.RemoveStorageConfigurationOptions{}
+	removeStorageConfigurationOptions.SetUUID(d.Get("uuid").(string))
+
+	_, response, err := satClient.RemoveStorageConfigurationWithContext(context, removeStorageConfigurationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] RemoveStorageConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("RemoveStorageConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// validateSatelliteStorageTemplateParameters checks the user parameters
+// against the storage template before they are sent, as the API only reports
+// the first invalid parameter once the configuration is applied.
+func validateSatelliteStorageTemplateParameters(context context.Context, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, d *schema.ResourceData) error {
+	getStorageTemplateOptions := &kubernetesserviceapiv1.GetStorageTemplateOptions{}
+	getStorageTemplateOptions.SetName(d.Get("storage_template_name").(string))
+	getStorageTemplateOptions.SetVersion(d.Get("storage_template_version").(string))
+
+	template, response, err := satClient.GetStorageTemplateWithContext(context, getStorageTemplateOptions)
+	if err != nil {
+		return fmt.Errorf("GetStorageTemplateWithContext failed %s\n%s", err, response)
+	}
+
+	userParameters := map[string]bool{}
+	for k := range d.Get("user_config_parameters").(map[string]interface{}) {
+		userParameters[k] = true
+	}
+	for k := range d.Get("user_secret_parameters").(map[string]interface{}) {
+		userParameters[k] = true
+	}
+
+	templateParameters := map[string]bool{}
+	missing := []string{}
+	for _, parameter := range template.CustomParameters {
+		if parameter.Name == nil {
+			continue
+		}
+		templateParameters[*parameter.Name] = true
+		if parameter.Required != nil && *parameter.Required == "true" && parameter.Default == nil && !userParameters[*parameter.Name] {
+			missing = append(missing, *parameter.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("[ERROR] Storage template %s requires the parameters: %s", *getStorageTemplateOptions.Name, strings.Join(missing, ", "))
+	}
+
+	unknown := []string{}
+	for k := range userParameters {
+		if !templateParameters[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("[ERROR] Storage template %s does not support the parameters: %s", *getStorageTemplateOptions.Name, strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+func expandSatelliteStorageClassParameters(storageClasses []interface{}) []map[string]string {
+	storageClassParameters := make([]map[string]string, 0, len(storageClasses))
+	for _, storageClass := range storageClasses {
+		if storageClass == nil {
+			continue
+		}
+		storageClassParameters = append(storageClassParameters, expandSatelliteStringMap(storageClass.(map[string]interface{})))
+	}
+	return storageClassParameters
+}
+
+func flattenSatelliteStorageClassParameters(storageClassParameters []map[string]string) []interface{} {
+	storageClasses := make([]interface{}, 0, len(storageClassParameters))
+	for _, storageClass := range storageClassParameters {
+		storageClasses = append(storageClasses, storageClass)
+	}
+	return storageClasses
+}
+
+func expandSatelliteStringMap(m map[string]interface{}) map[string]string {
+	stringMap := make(map[string]string, len(m))
+	for k, v := range m {
+		stringMap[k] = v.(string)
+	}
+	return stringMap
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIbmSatelliteStorageConfigurationBasic(t *testing.T) {
+	configName := fmt.Sprintf("tf-storage-config-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSatelliteStorageConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSatelliteStorageConfigurationConfig(configName, "/dev/sdc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSatelliteStorageConfigurationExists("ibm_satellite_storage_configuration.storage_configuration"),
+					resource.TestCheckResourceAttr("ibm_satellite_storage_configuration.storage_configuration", "config_name", configName),
+					resource.TestCheckResourceAttr("ibm_satellite_storage_configuration.storage_configuration", "user_config_parameters.devicepath", "/dev/sdc"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_storage_configuration.storage_configuration", "config_version"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_storage_configuration.storage_configuration", "uuid"),
+				),
+			},
+			{
+				Config: testAccCheckIbmSatelliteStorageConfigurationConfig(configName, "/dev/sdd"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_storage_configuration.storage_configuration", "user_config_parameters.devicepath", "/dev/sdd"),
+				),
+			},
+			{
+				ResourceName:            "ibm_satellite_storage_configuration.storage_configuration",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_secret_parameters"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteStorageConfigurationConfig(configName, devicePath string) string {
+	return fmt.Sprintf(`
+		resource "ibm_satellite_storage_configuration" "storage_configuration" {
+			location                 = "%s"
+			config_name              = "%s"
+			storage_template_name    = "local-volume-block"
+			storage_template_version = "4.12"
+			user_config_parameters = {
+				label-key   = "storage"
+				label-value = "localvolume"
+				devicepath  = "%s"
+			}
+		}
+	`, acc.Satellite_location_id, configName, devicePath)
+}
+
+func testAccCheckIbmSatelliteStorageConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		satClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SatelliteClientSession()
+		if err != nil {
+			return err
+		}
+
+		getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{}
+		getStorageConfigurationOptions.SetName(rs.Primary.ID[strings.LastIndex(rs.Primary.ID, "/")+1:])
+
+		_, _, err = satClient.GetStorageConfiguration(getStorageConfigurationOptions)
+		return err
+	}
+}
+
+func testAccCheckIbmSatelliteStorageConfigurationDestroy(s *terraform.State) error {
+	satClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_satellite_storage_configuration" {
+			continue
+		}
+
+		getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{}
+		getStorageConfigurationOptions.SetName(rs.Primary.ID[strings.LastIndex(rs.Primary.ID, "/")+1:])
+
+		storageConfig, response, err := satClient.GetStorageConfiguration(getStorageConfigurationOptions)
+		if err == nil && storageConfig != nil && storageConfig.UUID != nil {
+			return fmt.Errorf("satellite_storage_configuration still exists: %s", rs.Primary.ID)
+		} else if err != nil && response != nil && response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for satellite_storage_configuration (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSchematicsAgent() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSchematicsAgentRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent ID to get the details of agent.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Schematics location of the agent.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the agent (must be unique, for an account).",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Agent description.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource-group name for the agent.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags for the agent.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Agent version.",
+			},
+			"schematics_location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Schematics location that the agent connects to.",
+			},
+			"agent_location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The location where agent is deployed in the user environment.",
+			},
+			"agent_infrastructure": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The infrastructure parameters used by the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"infra_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of target agent infrastructure.",
+						},
+						"cluster_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cluster ID where agent services will be running.",
+						},
+						"cluster_resource_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource group of the cluster.",
+						},
+						"cos_instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The COS instance name to store the agent logs.",
+						},
+						"cos_bucket_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The COS bucket name used to store the logs.",
+						},
+						"cos_bucket_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The COS bucket region.",
+						},
+					},
+				},
+			},
+			"agent_metadata": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The metadata of an agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the metadata.",
+						},
+						"value": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the metadata name.",
+						},
+					},
+				},
+			},
+			"user_state": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "User defined status of the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User-defined states  * `enable` Agent is enabled by the user.  * `disable` Agent is disabled by the user.",
+						},
+						"set_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the User who set the state of the Object.",
+						},
+						"set_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the User who set the state of the Object.",
+						},
+					},
+				},
+			},
+			"agent_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent crn, obtained from the Schematics agent deployment configuration.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent creation date-time.",
+			},
+			"creation_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of an user who created the agent.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent registration updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who updated the agent registration.",
+			},
+			"system_state": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Computed state of the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Agent Status.",
+						},
+						"status_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The agent status message.",
+						},
+					},
+				},
+			},
+			"recent_prs_job":    schematicsAgentJobSchema("Run a pre-requisite scanner for deploying agent."),
+			"recent_deploy_job": schematicsAgentJobSchema("Post-installations checks for Agent health."),
+			"recent_health_job": schematicsAgentJobSchema("Agent health check."),
+		},
+	}
+}
+
+func dataSourceIBMSchematicsAgentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agentID := d.Get("agent_id").(string)
+	agent, response, err := getSchematicsAgent(context, schematicsClient, agentID)
+	if err != nil {
+		log.Printf("[DEBUG] GetAgentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAgentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(agentID)
+
+	if err = setSchematicsAgentData(d, agent); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSchematicsAgentDeploy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSchematicsAgentDeployRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent ID to get the details of agent.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Schematics location of the agent.",
+			},
+			"job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Job Id.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent deploy job updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who ran the agent deploy job.",
+			},
+			"agent_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Agent version.",
+			},
+			"is_redeployed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True, when the same version of the agent was redeployed.",
+			},
+			"status_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final result of the agent deploy job.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the agent deploy job, in a formatted log string.",
+			},
+			"log_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL to the full agent deploy job logs.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMSchematicsAgentDeployRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agentID := d.Get("agent_id").(string)
+	job, response, err := getSchematicsAgentJob(context, schematicsClient, agentID, "deploy")
+	if err != nil {
+		log.Printf("[DEBUG] GetDeployAgentJobWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetDeployAgentJobWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(agentID)
+
+	if err = setSchematicsAgentJobData(d, job); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_redeployed", job.IsRedeployed); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting is_redeployed: %s", err))
+	}
+
+	return nil
+}
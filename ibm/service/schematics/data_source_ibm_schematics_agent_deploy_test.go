@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsAgentDeployDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsAgentDeployDataSourceConfigBasic(acc.SchematicsAgentID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_agent_deploy.schematics_agent_deploy", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_agent_deploy.schematics_agent_deploy", "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsAgentDeployDataSourceConfigBasic(agentID string) string {
+	return fmt.Sprintf(`
+		data "ibm_schematics_agent_deploy" "schematics_agent_deploy" {
+			agent_id = "%s"
+			location = "us-south"
+		}
+	`, agentID)
+}
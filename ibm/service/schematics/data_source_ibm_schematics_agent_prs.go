@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSchematicsAgentPrs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSchematicsAgentPrsRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent ID to get the details of agent.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Schematics location of the agent.",
+			},
+			"job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Job Id.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The pre-requisite scanner job updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who ran the pre-requisite scanner job.",
+			},
+			"agent_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Agent version.",
+			},
+			"status_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final result of the pre-requisite scanner job.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the pre-requisite scanner job, in a formatted log string.",
+			},
+			"log_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL to the full pre-requisite scanner job logs.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMSchematicsAgentPrsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agentID := d.Get("agent_id").(string)
+	job, response, err := getSchematicsAgentJob(context, schematicsClient, agentID, "prs")
+	if err != nil {
+		log.Printf("[DEBUG] GetPrsAgentJobWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrsAgentJobWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(agentID)
+
+	if err = setSchematicsAgentJobData(d, job); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsAgentDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsAgentDataSourceConfigBasic(acc.SchematicsAgentID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_agent.schematics_agent", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_agent.schematics_agent", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsAgentDataSourceConfigBasic(agentID string) string {
+	return fmt.Sprintf(`
+		data "ibm_schematics_agent" "schematics_agent" {
+			agent_id = "%s"
+			location = "us-south"
+		}
+	`, agentID)
+}
@@ -103,6 +103,11 @@ func DataSourceIBMSchematicsWorkspace() *schema.Resource {
 				Computed:    true,
 				Description: "The workspace CRN.",
 			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the agent that runs the jobs of the workspace.",
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -615,6 +620,11 @@ func dataSourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Res
 	if err = d.Set("crn", workspaceResponse.Crn); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
 	}
+	if workspaceResponse.AgentInfo != nil {
+		if err = d.Set("agent_id", workspaceResponse.AgentInfo.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_id: %s", err))
+		}
+	}
 	if err = d.Set("description", workspaceResponse.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
 	}
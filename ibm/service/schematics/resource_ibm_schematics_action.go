@@ -886,6 +886,16 @@ func ResourceIBMSchematicsAction() *schema.Resource {
 				Optional:    true,
 				Description: "The personal access token to authenticate with your private GitHub or GitLab repository and access your Terraform template.",
 			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the agent that runs the jobs of the action. The action is assigned to the agent with an agent assignment policy.",
+			},
+			"agent_assignment_policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the agent assignment policy that assigns the action to the agent.",
+			},
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -1092,6 +1102,12 @@ func resourceIBMSchematicsActionCreate(context context.Context, d *schema.Resour
 
 	d.SetId(*action.ID)
 
+	if agentID, ok := d.GetOk("agent_id"); ok {
+		if err = resourceIBMSchematicsActionAssignAgent(context, schematicsClient, d, agentID.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMSchematicsActionRead(context, d, meta)
 }
 
@@ -1373,6 +1389,23 @@ func resourceIBMSchematicsActionRead(context context.Context, d *schema.Resource
 	if err = d.Set("location", action.Location); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting location: %s", err))
 	}
+	if policyID, ok := d.GetOk("agent_assignment_policy_id"); ok {
+		getPolicyOptions := &schematicsv1.GetPolicyOptions{}
+		getPolicyOptions.SetPolicyID(policyID.(string))
+		policy, response, err := schematicsClient.GetPolicyWithContext(context, getPolicyOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] GetPolicyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetPolicyWithContext failed %s\n%s", err, response))
+		}
+		if err != nil {
+			d.Set("agent_assignment_policy_id", "")
+			d.Set("agent_id", "")
+		} else if policy.PolicyTarget != nil && len(policy.PolicyTarget.SelectorIds) > 0 {
+			if err = d.Set("agent_id", policy.PolicyTarget.SelectorIds[0]); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_id: %s", err))
+			}
+		}
+	}
 	if err = d.Set("resource_group", action.ResourceGroup); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
 	}
@@ -1938,6 +1971,20 @@ func resourceIBMSchematicsActionUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("agent_id") {
+		if policyID, ok := d.GetOk("agent_assignment_policy_id"); ok {
+			if err = resourceIBMSchematicsActionDeleteAgentAssignment(context, schematicsClient, policyID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("agent_assignment_policy_id", "")
+		}
+		if agentID, ok := d.GetOk("agent_id"); ok {
+			if err = resourceIBMSchematicsActionAssignAgent(context, schematicsClient, d, agentID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceIBMSchematicsActionRead(context, d, meta)
 }
 
@@ -1952,6 +1999,12 @@ func resourceIBMSchematicsActionDelete(context context.Context, d *schema.Resour
 	if updatedURL {
 		schematicsClient.Service.Options.URL = schematicsURL
 	}
+	if policyID, ok := d.GetOk("agent_assignment_policy_id"); ok {
+		if err = resourceIBMSchematicsActionDeleteAgentAssignment(context, schematicsClient, policyID.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteActionOptions := &schematicsv1.DeleteActionOptions{}
 
 	deleteActionOptions.SetActionID(d.Id())
@@ -1966,3 +2019,50 @@ func resourceIBMSchematicsActionDelete(context context.Context, d *schema.Resour
 
 	return nil
 }
+
+// resourceIBMSchematicsActionAssignAgent creates an agent assignment policy
+// that selects the action by ID, so that its jobs run on the agent.
+func resourceIBMSchematicsActionAssignAgent(context context.Context, schematicsClient *schematicsv1.SchematicsV1, d *schema.ResourceData, agentID string) error {
+	createPolicyOptions := &schematicsv1.CreatePolicyOptions{}
+	createPolicyOptions.SetPolicyKind(schematicsv1.CreatePolicyOptions_PolicyKind_AgentAssignmentPolicy)
+	createPolicyOptions.SetName(fmt.Sprintf("%s-agent-assignment", d.Get("name").(string)))
+	createPolicyOptions.SetDescription(fmt.Sprintf("Assigns the Schematics action %s to the agent %s.", d.Id(), agentID))
+	if _, ok := d.GetOk("resource_group"); ok {
+		createPolicyOptions.SetResourceGroup(d.Get("resource_group").(string))
+	}
+	if _, ok := d.GetOk("location"); ok {
+		createPolicyOptions.SetLocation(d.Get("location").(string))
+	}
+	createPolicyOptions.SetPolicyTarget(&schematicsv1.PolicyObjects{
+		SelectorKind: core.StringPtr(schematicsv1.PolicyObjects_SelectorKind_Ids),
+		SelectorIds:  []string{agentID},
+	})
+	createPolicyOptions.SetPolicyParameter(&schematicsv1.PolicyParameter{
+		AgentAssignmentPolicyParameter: &schematicsv1.AgentAssignmentPolicyParameter{
+			SelectorKind: core.StringPtr(schematicsv1.AgentAssignmentPolicyParameter_SelectorKind_Ids),
+			SelectorIds:  []string{d.Id()},
+		},
+	})
+
+	policy, response, err := schematicsClient.CreatePolicyWithContext(context, createPolicyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePolicyWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("CreatePolicyWithContext failed %s\n%s", err, response)
+	}
+	if err = d.Set("agent_assignment_policy_id", policy.ID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting agent_assignment_policy_id: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMSchematicsActionDeleteAgentAssignment(context context.Context, schematicsClient *schematicsv1.SchematicsV1, policyID string) error {
+	deletePolicyOptions := &schematicsv1.DeletePolicyOptions{}
+	deletePolicyOptions.SetPolicyID(policyID)
+
+	response, err := schematicsClient.DeletePolicyWithContext(context, deletePolicyOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeletePolicyWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("DeletePolicyWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
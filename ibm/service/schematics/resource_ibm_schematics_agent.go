@@ -0,0 +1,753 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+// The agent APIs are not part of the schematics-go-sdk version used by the
+// provider, so the agent resources call the /v2/agents endpoints directly.
+
+const (
+	schematicsAgentJobStatusPending        = "job_pending"
+	schematicsAgentJobStatusReadyToExecute = "job_ready_to_execute"
+	schematicsAgentJobStatusInProgress     = "job_in_progress"
+	schematicsAgentJobStatusFinished       = "job_finished"
+	schematicsAgentJobStatusFailed         = "job_failed"
+	schematicsAgentJobStatusCancelled      = "job_cancelled"
+	schematicsAgentJobStatusStopped        = "job_stopped"
+)
+
+type schematicsAgentInfrastructure struct {
+	InfraType            *string `json:"infra_type,omitempty"`
+	ClusterID            *string `json:"cluster_id,omitempty"`
+	ClusterResourceGroup *string `json:"cluster_resource_group,omitempty"`
+	CosInstanceName      *string `json:"cos_instance_name,omitempty"`
+	CosBucketName        *string `json:"cos_bucket_name,omitempty"`
+	CosBucketRegion      *string `json:"cos_bucket_region,omitempty"`
+}
+
+type schematicsAgentMetadata struct {
+	Name  *string  `json:"name,omitempty"`
+	Value []string `json:"value,omitempty"`
+}
+
+type schematicsAgentPrototype struct {
+	Name                *string                        `json:"name,omitempty"`
+	Description         *string                        `json:"description,omitempty"`
+	ResourceGroup       *string                        `json:"resource_group,omitempty"`
+	Tags                []string                       `json:"tags,omitempty"`
+	Version             *string                        `json:"version,omitempty"`
+	SchematicsLocation  *string                        `json:"schematics_location,omitempty"`
+	AgentLocation       *string                        `json:"agent_location,omitempty"`
+	AgentInfrastructure *schematicsAgentInfrastructure `json:"agent_infrastructure,omitempty"`
+	AgentMetadata       []schematicsAgentMetadata      `json:"agent_metadata,omitempty"`
+	UserState           *schematicsv1.AgentUserState   `json:"user_state,omitempty"`
+}
+
+type schematicsAgentSystemState struct {
+	StatusCode    *string `json:"status_code,omitempty"`
+	StatusMessage *string `json:"status_message,omitempty"`
+}
+
+// schematicsAgentJob is the latest pre-requisite scanner, deploy or health
+// job of an agent.
+type schematicsAgentJob struct {
+	AgentID       *string          `json:"agent_id,omitempty"`
+	JobID         *string          `json:"job_id,omitempty"`
+	UpdatedAt     *strfmt.DateTime `json:"updated_at,omitempty"`
+	UpdatedBy     *string          `json:"updated_by,omitempty"`
+	AgentVersion  *string          `json:"agent_version,omitempty"`
+	IsRedeployed  *bool            `json:"is_redeployed,omitempty"`
+	StatusCode    *string          `json:"status_code,omitempty"`
+	StatusMessage *string          `json:"status_message,omitempty"`
+	LogURL        *string          `json:"log_url,omitempty"`
+}
+
+type schematicsAgent struct {
+	schematicsAgentPrototype
+	ID              *string                     `json:"id,omitempty"`
+	AgentCrn        *string                     `json:"agent_crn,omitempty"`
+	CreatedAt       *strfmt.DateTime            `json:"created_at,omitempty"`
+	CreationBy      *string                     `json:"creation_by,omitempty"`
+	UpdatedAt       *strfmt.DateTime            `json:"updated_at,omitempty"`
+	UpdatedBy       *string                     `json:"updated_by,omitempty"`
+	SystemState     *schematicsAgentSystemState `json:"system_state,omitempty"`
+	RecentPrsJob    *schematicsAgentJob         `json:"recent_prs_job,omitempty"`
+	RecentDeployJob *schematicsAgentJob         `json:"recent_deploy_job,omitempty"`
+	RecentHealthJob *schematicsAgentJob         `json:"recent_health_job,omitempty"`
+}
+
+func ResourceIBMSchematicsAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsAgentCreate,
+		ReadContext:   resourceIBMSchematicsAgentRead,
+		UpdateContext: resourceIBMSchematicsAgentUpdate,
+		DeleteContext: resourceIBMSchematicsAgentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the agent (must be unique, for an account).",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The resource-group name for the agent.  By default, agent will be registered in Default Resource Group.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent version.",
+			},
+			"schematics_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "schematics_location"),
+				Description:  "The Schematics location that the agent connects to.",
+			},
+			"agent_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location where agent is deployed in the user environment.",
+			},
+			"agent_infrastructure": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Required:    true,
+				Description: "The infrastructure parameters used by the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"infra_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "infra_type"),
+							Description:  "Type of target agent infrastructure.",
+						},
+						"cluster_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The cluster ID where agent services will be running.",
+						},
+						"cluster_resource_group": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The resource group of the cluster.",
+						},
+						"cos_instance_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The COS instance name to store the agent logs.",
+						},
+						"cos_bucket_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The COS bucket name used to store the logs.",
+						},
+						"cos_bucket_region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The COS bucket region.",
+						},
+					},
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Agent description.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags for the agent.",
+			},
+			"agent_metadata": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The metadata of an agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the metadata.",
+						},
+						"value": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the metadata name.",
+						},
+					},
+				},
+			},
+			"user_state": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined status of the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "state"),
+							Description:  "User-defined states  * `enable` Agent is enabled by the user.  * `disable` Agent is disabled by the user.",
+						},
+						"set_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the User who set the state of the Object.",
+						},
+						"set_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the User who set the state of the Object.",
+						},
+					},
+				},
+			},
+			"agent_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent crn, obtained from the Schematics agent deployment configuration.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent creation date-time.",
+			},
+			"creation_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of an user who created the agent.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent registration updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who updated the agent registration.",
+			},
+			"system_state": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Computed state of the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Agent Status.",
+						},
+						"status_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The agent status message.",
+						},
+					},
+				},
+			},
+			"recent_prs_job":    schematicsAgentJobSchema("Run a pre-requisite scanner for deploying agent."),
+			"recent_deploy_job": schematicsAgentJobSchema("Post-installations checks for Agent health."),
+			"recent_health_job": schematicsAgentJobSchema("Agent health check."),
+		},
+	}
+}
+
+func ResourceIBMSchematicsAgentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "schematics_location",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "ca-tor, eu-de, eu-gb, us-east, us-south",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "infra_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ibm_kubernetes, ibm_openshift, ibm_satellite",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "state",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disable, enable",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_schematics_agent", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func schematicsAgentJobSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"agent_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Id of the agent.",
+				},
+				"job_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Job Id.",
+				},
+				"updated_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The agent job updation time.",
+				},
+				"updated_by": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Email address of user who ran the agent job.",
+				},
+				"agent_version": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Agent version.",
+				},
+				"status_code": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Final result of the agent job.",
+				},
+				"status_message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The outcome of the agent job, in a formatted log string.",
+				},
+				"log_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "URL to the full agent job logs.",
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsAgentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("schematics_location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agent := &schematicsAgent{}
+	response, err := schematicsAgentRequest(context, schematicsClient, "POST", "/v2/agents", "", nil, resourceIBMSchematicsAgentPrototype(d), agent)
+	if err != nil {
+		log.Printf("[DEBUG] CreateAgentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateAgentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*agent.ID)
+
+	return resourceIBMSchematicsAgentRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("schematics_location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agent, response, err := getSchematicsAgent(context, schematicsClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAgentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAgentWithContext failed %s\n%s", err, response))
+	}
+
+	if err = setSchematicsAgentData(d, agent); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsAgentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("schematics_location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "resource_group", "version", "agent_infrastructure", "description", "tags", "agent_metadata", "user_state") {
+		response, err := schematicsAgentRequest(context, schematicsClient, "PUT", "/v2/agents/{agent_id}", d.Id(), nil, resourceIBMSchematicsAgentPrototype(d), &schematicsAgent{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateAgentWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateAgentWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMSchematicsAgentRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("schematics_location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := schematicsAgentRequest(context, schematicsClient, "DELETE", "/v2/agents/{agent_id}", d.Id(), nil, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteAgentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteAgentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMSchematicsAgentPrototype(d *schema.ResourceData) *schematicsAgentPrototype {
+	prototype := &schematicsAgentPrototype{
+		Name:               core.StringPtr(d.Get("name").(string)),
+		ResourceGroup:      core.StringPtr(d.Get("resource_group").(string)),
+		Version:            core.StringPtr(d.Get("version").(string)),
+		SchematicsLocation: core.StringPtr(d.Get("schematics_location").(string)),
+		AgentLocation:      core.StringPtr(d.Get("agent_location").(string)),
+		Description:        core.StringPtr(d.Get("description").(string)),
+		Tags:               flex.ExpandStringList(d.Get("tags").([]interface{})),
+	}
+
+	if infrastructure, ok := d.GetOk("agent_infrastructure.0"); ok {
+		infrastructureMap := infrastructure.(map[string]interface{})
+		prototype.AgentInfrastructure = &schematicsAgentInfrastructure{}
+		if v, ok := infrastructureMap["infra_type"].(string); ok && v != "" {
+			prototype.AgentInfrastructure.InfraType = core.StringPtr(v)
+		}
+		if v, ok := infrastructureMap["cluster_id"].(string); ok && v != "" {
+			prototype.AgentInfrastructure.ClusterID = core.StringPtr(v)
+		}
+		if v, ok := infrastructureMap["cluster_resource_group"].(string); ok && v != "" {
+			prototype.AgentInfrastructure.ClusterResourceGroup = core.StringPtr(v)
+		}
+		if v, ok := infrastructureMap["cos_instance_name"].(string); ok && v != "" {
+			prototype.AgentInfrastructure.CosInstanceName = core.StringPtr(v)
+		}
+		if v, ok := infrastructureMap["cos_bucket_name"].(string); ok && v != "" {
+			prototype.AgentInfrastructure.CosBucketName = core.StringPtr(v)
+		}
+		if v, ok := infrastructureMap["cos_bucket_region"].(string); ok && v != "" {
+			prototype.AgentInfrastructure.CosBucketRegion = core.StringPtr(v)
+		}
+	}
+
+	for _, metadata := range d.Get("agent_metadata").([]interface{}) {
+		metadataMap, ok := metadata.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		prototype.AgentMetadata = append(prototype.AgentMetadata, schematicsAgentMetadata{
+			Name:  core.StringPtr(metadataMap["name"].(string)),
+			Value: flex.ExpandStringList(metadataMap["value"].([]interface{})),
+		})
+	}
+
+	if state, ok := d.GetOk("user_state.0.state"); ok {
+		prototype.UserState = &schematicsv1.AgentUserState{
+			State: core.StringPtr(state.(string)),
+		}
+	}
+
+	return prototype
+}
+
+func setSchematicsAgentData(d *schema.ResourceData, agent *schematicsAgent) error {
+	if err := d.Set("name", agent.Name); err != nil {
+		return fmt.Errorf("[ERROR] Error setting name: %s", err)
+	}
+	if err := d.Set("resource_group", agent.ResourceGroup); err != nil {
+		return fmt.Errorf("[ERROR] Error setting resource_group: %s", err)
+	}
+	if err := d.Set("version", agent.Version); err != nil {
+		return fmt.Errorf("[ERROR] Error setting version: %s", err)
+	}
+	if err := d.Set("schematics_location", agent.SchematicsLocation); err != nil {
+		return fmt.Errorf("[ERROR] Error setting schematics_location: %s", err)
+	}
+	if err := d.Set("agent_location", agent.AgentLocation); err != nil {
+		return fmt.Errorf("[ERROR] Error setting agent_location: %s", err)
+	}
+	if agent.AgentInfrastructure != nil {
+		infrastructureMap := map[string]interface{}{
+			"infra_type":             core.StringNilMapper(agent.AgentInfrastructure.InfraType),
+			"cluster_id":             core.StringNilMapper(agent.AgentInfrastructure.ClusterID),
+			"cluster_resource_group": core.StringNilMapper(agent.AgentInfrastructure.ClusterResourceGroup),
+			"cos_instance_name":      core.StringNilMapper(agent.AgentInfrastructure.CosInstanceName),
+			"cos_bucket_name":        core.StringNilMapper(agent.AgentInfrastructure.CosBucketName),
+			"cos_bucket_region":      core.StringNilMapper(agent.AgentInfrastructure.CosBucketRegion),
+		}
+		if err := d.Set("agent_infrastructure", []map[string]interface{}{infrastructureMap}); err != nil {
+			return fmt.Errorf("[ERROR] Error setting agent_infrastructure: %s", err)
+		}
+	}
+	if err := d.Set("description", agent.Description); err != nil {
+		return fmt.Errorf("[ERROR] Error setting description: %s", err)
+	}
+	if agent.Tags != nil {
+		if err := d.Set("tags", agent.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	agentMetadata := []map[string]interface{}{}
+	for _, metadata := range agent.AgentMetadata {
+		agentMetadata = append(agentMetadata, map[string]interface{}{
+			"name":  core.StringNilMapper(metadata.Name),
+			"value": metadata.Value,
+		})
+	}
+	if err := d.Set("agent_metadata", agentMetadata); err != nil {
+		return fmt.Errorf("[ERROR] Error setting agent_metadata: %s", err)
+	}
+	if agent.UserState != nil {
+		userStateMap := map[string]interface{}{
+			"state":  core.StringNilMapper(agent.UserState.State),
+			"set_by": core.StringNilMapper(agent.UserState.SetBy),
+			"set_at": flex.DateTimeToString(agent.UserState.SetAt),
+		}
+		if err := d.Set("user_state", []map[string]interface{}{userStateMap}); err != nil {
+			return fmt.Errorf("[ERROR] Error setting user_state: %s", err)
+		}
+	}
+	if err := d.Set("agent_crn", agent.AgentCrn); err != nil {
+		return fmt.Errorf("[ERROR] Error setting agent_crn: %s", err)
+	}
+	if err := d.Set("created_at", flex.DateTimeToString(agent.CreatedAt)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting created_at: %s", err)
+	}
+	if err := d.Set("creation_by", agent.CreationBy); err != nil {
+		return fmt.Errorf("[ERROR] Error setting creation_by: %s", err)
+	}
+	if err := d.Set("updated_at", flex.DateTimeToString(agent.UpdatedAt)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting updated_at: %s", err)
+	}
+	if err := d.Set("updated_by", agent.UpdatedBy); err != nil {
+		return fmt.Errorf("[ERROR] Error setting updated_by: %s", err)
+	}
+	systemState := []map[string]interface{}{}
+	if agent.SystemState != nil {
+		systemState = append(systemState, map[string]interface{}{
+			"status_code":    core.StringNilMapper(agent.SystemState.StatusCode),
+			"status_message": core.StringNilMapper(agent.SystemState.StatusMessage),
+		})
+	}
+	if err := d.Set("system_state", systemState); err != nil {
+		return fmt.Errorf("[ERROR] Error setting system_state: %s", err)
+	}
+	if err := d.Set("recent_prs_job", flattenSchematicsAgentJob(agent.RecentPrsJob)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting recent_prs_job: %s", err)
+	}
+	if err := d.Set("recent_deploy_job", flattenSchematicsAgentJob(agent.RecentDeployJob)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting recent_deploy_job: %s", err)
+	}
+	if err := d.Set("recent_health_job", flattenSchematicsAgentJob(agent.RecentHealthJob)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting recent_health_job: %s", err)
+	}
+	return nil
+}
+
+func flattenSchematicsAgentJob(job *schematicsAgentJob) []map[string]interface{} {
+	if job == nil {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{{
+		"agent_id":       core.StringNilMapper(job.AgentID),
+		"job_id":         core.StringNilMapper(job.JobID),
+		"updated_at":     flex.DateTimeToString(job.UpdatedAt),
+		"updated_by":     core.StringNilMapper(job.UpdatedBy),
+		"agent_version":  core.StringNilMapper(job.AgentVersion),
+		"status_code":    core.StringNilMapper(job.StatusCode),
+		"status_message": core.StringNilMapper(job.StatusMessage),
+		"log_url":        core.StringNilMapper(job.LogURL),
+	}}
+}
+
+func schematicsAgentClient(location string, meta interface{}) (*schematicsv1.SchematicsV1, error) {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return nil, err
+	}
+	if location != "" {
+		schematicsURL, updatedURL, _ := SchematicsEndpointURL(location, meta)
+		if updatedURL {
+			schematicsClient.Service.Options.URL = schematicsURL
+		}
+	}
+	return schematicsClient, nil
+}
+
+func getSchematicsAgent(context context.Context, schematicsClient *schematicsv1.SchematicsV1, agentID string) (*schematicsAgent, *core.DetailedResponse, error) {
+	agent := &schematicsAgent{}
+	response, err := schematicsAgentRequest(context, schematicsClient, "GET", "/v2/agents/{agent_id}", agentID, map[string]string{"profile": "detailed"}, nil, agent)
+	if err != nil {
+		return nil, response, err
+	}
+	return agent, response, nil
+}
+
+func schematicsAgentRequest(context context.Context, schematicsClient *schematicsv1.SchematicsV1, method string, path string, agentID string, query map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = schematicsClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(schematicsClient.Service.Options.URL, path, map[string]string{"agent_id": agentID})
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return schematicsClient.Service.Request(request, result)
+}
+
+// runSchematicsAgentJob starts the prs, deploy or health job of the agent
+// and waits until it finishes. A job that doesn't finish successfully is
+// returned with an error.
+func runSchematicsAgentJob(context context.Context, schematicsClient *schematicsv1.SchematicsV1, agentID string, jobKind string, force bool, timeout time.Duration) (*schematicsAgentJob, error) {
+	path := fmt.Sprintf("/v2/agents/{agent_id}/%s", jobKind)
+
+	var query map[string]string
+	if force {
+		query = map[string]string{"force": "true"}
+	}
+	startedAt := time.Now()
+	started := &schematicsAgentJob{}
+	response, err := schematicsAgentRequest(context, schematicsClient, "PUT", path, agentID, query, nil, started)
+	if err != nil {
+		log.Printf("[DEBUG] Agent %s job of %s failed to start %s\n%s", jobKind, agentID, err, response)
+		return nil, fmt.Errorf("[ERROR] Agent %s job of %s failed to start %s\n%s", jobKind, agentID, err, response)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"",
+			schematicsAgentJobStatusPending,
+			schematicsAgentJobStatusReadyToExecute,
+			schematicsAgentJobStatusInProgress,
+		},
+		Target: []string{
+			schematicsAgentJobStatusFinished,
+			schematicsAgentJobStatusFailed,
+			schematicsAgentJobStatusCancelled,
+			schematicsAgentJobStatusStopped,
+		},
+		Refresh: func() (interface{}, string, error) {
+			job, response, err := getSchematicsAgentJob(context, schematicsClient, agentID, jobKind)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting the agent %s job of %s: %s\n%s", jobKind, agentID, err, response)
+			}
+			// Until the new job is registered the previous one is returned. When
+			// the start response has no job ID, the new job is the first one
+			// updated after the start.
+			if started.JobID != nil {
+				if job.JobID == nil || *started.JobID != *job.JobID {
+					return job, schematicsAgentJobStatusPending, nil
+				}
+			} else if job.UpdatedAt == nil || !time.Time(*job.UpdatedAt).After(startedAt) {
+				return job, schematicsAgentJobStatusPending, nil
+			}
+			return job, core.StringNilMapper(job.StatusCode), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error waiting for the agent %s job of %s: %s", jobKind, agentID, err)
+	}
+
+	job := result.(*schematicsAgentJob)
+	if *job.StatusCode != schematicsAgentJobStatusFinished {
+		return job, fmt.Errorf("[ERROR] Agent %s job %s of %s finished with status %s: %s\nLogs: %s", jobKind, core.StringNilMapper(job.JobID), agentID, *job.StatusCode, core.StringNilMapper(job.StatusMessage), core.StringNilMapper(job.LogURL))
+	}
+	return job, nil
+}
+
+func setSchematicsAgentJobData(d *schema.ResourceData, job *schematicsAgentJob) error {
+	if err := d.Set("job_id", job.JobID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting job_id: %s", err)
+	}
+	if err := d.Set("updated_at", flex.DateTimeToString(job.UpdatedAt)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting updated_at: %s", err)
+	}
+	if err := d.Set("updated_by", job.UpdatedBy); err != nil {
+		return fmt.Errorf("[ERROR] Error setting updated_by: %s", err)
+	}
+	if err := d.Set("agent_version", job.AgentVersion); err != nil {
+		return fmt.Errorf("[ERROR] Error setting agent_version: %s", err)
+	}
+	if err := d.Set("status_code", job.StatusCode); err != nil {
+		return fmt.Errorf("[ERROR] Error setting status_code: %s", err)
+	}
+	if err := d.Set("status_message", job.StatusMessage); err != nil {
+		return fmt.Errorf("[ERROR] Error setting status_message: %s", err)
+	}
+	if err := d.Set("log_url", job.LogURL); err != nil {
+		return fmt.Errorf("[ERROR] Error setting log_url: %s", err)
+	}
+	return nil
+}
+
+func getSchematicsAgentJob(context context.Context, schematicsClient *schematicsv1.SchematicsV1, agentID string, jobKind string) (*schematicsAgentJob, *core.DetailedResponse, error) {
+	job := &schematicsAgentJob{}
+	response, err := schematicsAgentRequest(context, schematicsClient, "GET", fmt.Sprintf("/v2/agents/{agent_id}/%s", jobKind), agentID, nil, nil, job)
+	if err != nil {
+		return nil, response, err
+	}
+	return job, response, nil
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSchematicsAgentDeploy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsAgentDeployCreate,
+		ReadContext:   resourceIBMSchematicsAgentDeployRead,
+		UpdateContext: resourceIBMSchematicsAgentDeployUpdate,
+		DeleteContext: resourceIBMSchematicsAgentDeployDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Agent ID to get the details of agent.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Schematics location of the agent.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Equivalent to -force options in the command line, default is false. Changing it deploys the agent again.",
+			},
+			"job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Job Id.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent deploy job updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who ran the agent deploy job.",
+			},
+			"agent_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Agent version.",
+			},
+			"is_redeployed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True, when the same version of the agent was redeployed.",
+			},
+			"status_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final result of the agent deploy job.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the agent deploy job, in a formatted log string.",
+			},
+			"log_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL to the full agent deployment job logs.",
+			},
+			"health_status_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final result of the health check job that runs after the deployment.",
+			},
+			"health_status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the health check job that runs after the deployment.",
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsAgentDeployCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("agent_id").(string))
+
+	if err := resourceIBMSchematicsAgentDeployRun(context, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSchematicsAgentDeployRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentDeployRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	job, response, err := getSchematicsAgentJob(context, schematicsClient, d.Id(), "deploy")
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetDeployAgentJobWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetDeployAgentJobWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("agent_id", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_id: %s", err))
+	}
+	if err = setSchematicsAgentJobData(d, job); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_redeployed", job.IsRedeployed); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting is_redeployed: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsAgentDeployUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("force") {
+		if err := resourceIBMSchematicsAgentDeployRun(context, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSchematicsAgentDeployRead(context, d, meta)
+}
+
+// Deleting the resource doesn't remove the agent from the cluster, it only
+// removes the deploy job from the state. The agent is removed with the
+// ibm_schematics_agent resource.
+func resourceIBMSchematicsAgentDeployDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMSchematicsAgentDeployRun deploys the agent and then runs a health
+// check, so that the resource is only created once the agent can run jobs.
+func resourceIBMSchematicsAgentDeployRun(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	schematicsClient, err := schematicsAgentClient(d.Get("location").(string), meta)
+	if err != nil {
+		return err
+	}
+
+	job, err := runSchematicsAgentJob(context, schematicsClient, d.Id(), "deploy", d.Get("force").(bool), timeout)
+	if job != nil {
+		if setErr := setSchematicsAgentJobData(d, job); setErr != nil {
+			return setErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	healthJob, err := runSchematicsAgentJob(context, schematicsClient, d.Id(), "health", false, timeout)
+	if healthJob != nil {
+		d.Set("health_status_code", healthJob.StatusCode)
+		d.Set("health_status_message", healthJob.StatusMessage)
+	}
+	return err
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSchematicsAgentPrs() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsAgentPrsCreate,
+		ReadContext:   resourceIBMSchematicsAgentPrsRead,
+		UpdateContext: resourceIBMSchematicsAgentPrsUpdate,
+		DeleteContext: resourceIBMSchematicsAgentPrsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Agent ID to get the details of agent.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Schematics location of the agent.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Equivalent to -force options in the command line, default is false. Changing it runs the scanner again.",
+			},
+			"job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Job Id.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent prs job updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who ran the agent prs job.",
+			},
+			"agent_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Agent version.",
+			},
+			"status_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final result of the pre-requisite scanner job.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the pre-requisite scanner job, in a formatted log string.",
+			},
+			"log_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL to the full pre-requisite scanner job logs.",
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsAgentPrsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("agent_id").(string))
+
+	if err := resourceIBMSchematicsAgentPrsRun(context, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSchematicsAgentPrsRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentPrsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsAgentClient(d.Get("location").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	job, response, err := getSchematicsAgentJob(context, schematicsClient, d.Id(), "prs")
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrsAgentJobWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrsAgentJobWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("agent_id", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_id: %s", err))
+	}
+	if err = setSchematicsAgentJobData(d, job); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsAgentPrsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("force") {
+		if err := resourceIBMSchematicsAgentPrsRun(context, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSchematicsAgentPrsRead(context, d, meta)
+}
+
+// The pre-requisite scanner job stays in the agent history, so deleting the
+// resource only removes it from the state.
+func resourceIBMSchematicsAgentPrsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMSchematicsAgentPrsRun(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	schematicsClient, err := schematicsAgentClient(d.Get("location").(string), meta)
+	if err != nil {
+		return err
+	}
+
+	job, err := runSchematicsAgentJob(context, schematicsClient, d.Id(), "prs", d.Get("force").(bool), timeout)
+	if job != nil {
+		if setErr := setSchematicsAgentJobData(d, job); setErr != nil {
+			return setErr
+		}
+	}
+	return err
+}
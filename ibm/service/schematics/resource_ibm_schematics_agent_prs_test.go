@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsAgentPrsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMSchematicsAgentPrsConfig(acc.SchematicsAgentID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_agent_prs.schematics_agent_prs", "agent_id", acc.SchematicsAgentID),
+					resource.TestCheckResourceAttrSet("ibm_schematics_agent_prs.schematics_agent_prs", "job_id"),
+					resource.TestCheckResourceAttr("ibm_schematics_agent_prs.schematics_agent_prs", "status_code", "job_finished"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsAgentPrsConfig(agentID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_schematics_agent_prs" "schematics_agent_prs" {
+			agent_id = "%s"
+			location = "us-south"
+		}
+	`, agentID)
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsAgentBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-agent-%d", acctest.RandIntRange(10, 100))
+	description := "tf-acc-test agent"
+	descriptionUpdate := "tf-acc-test agent updated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMSchematicsAgentConfig(name, description, acc.IksClusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "name", name),
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "description", description),
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "schematics_location", "us-south"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_agent.schematics_agent", "agent_crn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMSchematicsAgentConfig(name, descriptionUpdate, acc.IksClusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "description", descriptionUpdate),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_schematics_agent.schematics_agent",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsAgentConfig(name string, description string, clusterID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_schematics_agent" "schematics_agent" {
+			name = "%s"
+			description = "%s"
+			resource_group = "default"
+			version = "1.0.0"
+			schematics_location = "us-south"
+			agent_location = "us-south"
+			agent_infrastructure {
+				infra_type = "ibm_kubernetes"
+				cluster_id = "%s"
+				cluster_resource_group = "default"
+				cos_instance_name = "tf-acc-test-agent-cos"
+				cos_bucket_name = "tf-acc-test-agent-bucket"
+				cos_bucket_region = "us-south"
+			}
+			tags = ["tf-acc-test"]
+		}
+	`, name, description, clusterID)
+}
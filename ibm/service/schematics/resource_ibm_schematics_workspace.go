@@ -4,9 +4,18 @@
 package schematics
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -34,6 +43,7 @@ func ResourceIBMSchematicsWorkspace() *schema.Resource {
 		UpdateContext: resourceIBMSchematicsWorkspaceUpdate,
 		DeleteContext: resourceIBMSchematicsWorkspaceDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMSchematicsWorkspaceTemplateHashDiff,
 
 		Schema: map[string]*schema.Schema{
 			"applied_shareddata_ids": {
@@ -406,6 +416,23 @@ func ResourceIBMSchematicsWorkspace() *schema.Resource {
 				Computed:    true,
 				Description: "Has uploaded git repo tar",
 			},
+			"template_source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_tar_file", "template_git_url"},
+				Description:   "The local directory with the Terraform template. The directory is archived and uploaded to the workspace on create and whenever its content changes.",
+			},
+			"template_tar_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_source_dir", "template_git_url"},
+				Description:   "The local .tar or .tar.gz file with the Terraform template. The file is uploaded to the workspace on create and whenever its content changes.",
+			},
+			"template_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 hash of the uploaded template.",
+			},
 			/*"template_type": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -450,6 +477,11 @@ func ResourceIBMSchematicsWorkspace() *schema.Resource {
 				Optional:    true,
 				Description: "The personal access token to authenticate with your private GitHub or GitLab repository and access your Terraform template.",
 			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the agent that runs the jobs of the workspace. Removing it unpins the workspace from the agent.",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -745,6 +777,9 @@ func resourceIBMSchematicsWorkspaceCreate(context context.Context, d *schema.Res
 	if _, ok := d.GetOk("x_github_token"); ok {
 		createWorkspaceOptions.SetXGithubToken(d.Get("x_github_token").(string))
 	}
+	if _, ok := d.GetOk("agent_id"); ok {
+		createWorkspaceOptions.SetAgentID(d.Get("agent_id").(string))
+	}
 
 	workspaceResponse, response, err := schematicsClient.CreateWorkspaceWithContext(context, createWorkspaceOptions)
 	if err != nil {
@@ -754,6 +789,19 @@ func resourceIBMSchematicsWorkspaceCreate(context context.Context, d *schema.Res
 
 	d.SetId(*workspaceResponse.ID)
 
+	if template, hash, ok, err := resourceIBMSchematicsWorkspaceTemplateArchive(d); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		if len(workspaceResponse.TemplateData) == 0 || workspaceResponse.TemplateData[0].ID == nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Workspace %s has no template to upload %s to", d.Id(), template.name))
+		}
+		err = resourceIBMSchematicsWorkspaceUploadTemplate(context, schematicsClient, d.Id(), *workspaceResponse.TemplateData[0].ID, template)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("template_hash", hash)
+	}
+
 	return resourceIBMSchematicsWorkspaceRead(context, d, meta)
 }
 
@@ -1223,6 +1271,11 @@ func resourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Resou
 	if err = d.Set("crn", workspaceResponse.Crn); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error reading crn: %s", err))
 	}
+	if workspaceResponse.AgentInfo != nil {
+		if err = d.Set("agent_id", workspaceResponse.AgentInfo.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_id: %s", err))
+		}
+	}
 	if workspaceResponse.LastHealthCheckAt != nil {
 		if err = d.Set("last_health_check_at", workspaceResponse.LastHealthCheckAt.String()); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error reading last_health_check_at: %s", err))
@@ -1679,6 +1732,12 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 		hasChange = true
 		metadataChange = true
 	}
+	if d.HasChange("agent_id") {
+		updateWorkspaceOptions.SetAgentID(d.Get("agent_id").(string))
+		replaceWorkspaceOptions.SetAgentID(d.Get("agent_id").(string))
+		hasChange = true
+		metadataChange = true
+	}
 
 	var templateData []schematicsv1.TemplateSourceDataRequest
 
@@ -1804,6 +1863,25 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 		metadataChange = true
 	}
 
+	if d.HasChange("template_hash") {
+		template, hash, ok, err := resourceIBMSchematicsWorkspaceTemplateArchive(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if ok {
+			runtimeData := d.Get("runtime_data").([]interface{})
+			if len(runtimeData) == 0 || runtimeData[0] == nil || runtimeData[0].(map[string]interface{})["id"].(string) == "" {
+				return diag.FromErr(fmt.Errorf("[ERROR] Workspace %s has no template to upload %s to", d.Id(), template.name))
+			}
+			templateID := runtimeData[0].(map[string]interface{})["id"].(string)
+			err = resourceIBMSchematicsWorkspaceUploadTemplate(context, schematicsClient, d.Id(), templateID, template)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("template_hash", hash)
+		}
+	}
+
 	if hasChange {
 		changed := false
 
@@ -1890,3 +1968,132 @@ func resourceIBMSchematicsWorkspaceDelete(context context.Context, d *schema.Res
 
 	return nil
 }
+
+// schematicsTemplateArchive is a template archive to upload to a workspace.
+type schematicsTemplateArchive struct {
+	name    string
+	content []byte
+}
+
+// resourceIBMSchematicsWorkspaceTemplateHashDiff plans an upload when the
+// content of template_source_dir or template_tar_file changes.
+func resourceIBMSchematicsWorkspaceTemplateHashDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var hash string
+	var err error
+	if sourceDir, ok := diff.GetOk("template_source_dir"); ok {
+		_, hash, err = schematicsTemplateDirArchive(sourceDir.(string))
+	} else if tarFile, ok := diff.GetOk("template_tar_file"); ok {
+		_, hash, err = schematicsTemplateFileArchive(tarFile.(string))
+	} else {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if diff.Get("template_hash").(string) != hash {
+		return diff.SetNew("template_hash", hash)
+	}
+	return nil
+}
+
+// resourceIBMSchematicsWorkspaceTemplateArchive returns the archive of
+// template_source_dir or template_tar_file, and false when neither is set.
+func resourceIBMSchematicsWorkspaceTemplateArchive(d *schema.ResourceData) (*schematicsTemplateArchive, string, bool, error) {
+	if sourceDir, ok := d.GetOk("template_source_dir"); ok {
+		template, hash, err := schematicsTemplateDirArchive(sourceDir.(string))
+		return template, hash, true, err
+	}
+	if tarFile, ok := d.GetOk("template_tar_file"); ok {
+		template, hash, err := schematicsTemplateFileArchive(tarFile.(string))
+		return template, hash, true, err
+	}
+	return nil, "", false, nil
+}
+
+// schematicsTemplateDirArchive archives the directory into a .tar.gz file.
+// The .git and .terraform directories are left out, and the file times are
+// not archived so that the hash only depends on the content and the
+// permissions of the files.
+func schematicsTemplateDirArchive(sourceDir string) (*schematicsTemplateArchive, string, error) {
+	var tarContent bytes.Buffer
+	tarWriter := tar.NewWriter(&tarContent)
+	err := filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && (info.Name() == ".git" || info.Name() == ".terraform") {
+			return filepath.SkipDir
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		name, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		header := &tar.Header{
+			Name: filepath.ToSlash(name),
+			Mode: int64(info.Mode().Perm()),
+			Size: int64(len(content)),
+		}
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		_, err = tarWriter.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error archiving template directory %s: %s", sourceDir, err)
+	}
+	if err = tarWriter.Close(); err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error archiving template directory %s: %s", sourceDir, err)
+	}
+	hash := sha256.Sum256(tarContent.Bytes())
+
+	var gzipContent bytes.Buffer
+	gzipWriter := gzip.NewWriter(&gzipContent)
+	if _, err = gzipWriter.Write(tarContent.Bytes()); err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error compressing template directory %s: %s", sourceDir, err)
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error compressing template directory %s: %s", sourceDir, err)
+	}
+
+	template := &schematicsTemplateArchive{
+		name:    filepath.Base(filepath.Clean(sourceDir)) + ".tar.gz",
+		content: gzipContent.Bytes(),
+	}
+	return template, hex.EncodeToString(hash[:]), nil
+}
+
+func schematicsTemplateFileArchive(tarFile string) (*schematicsTemplateArchive, string, error) {
+	content, err := ioutil.ReadFile(tarFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error reading template file %s: %s", tarFile, err)
+	}
+	hash := sha256.Sum256(content)
+	template := &schematicsTemplateArchive{
+		name:    filepath.Base(tarFile),
+		content: content,
+	}
+	return template, hex.EncodeToString(hash[:]), nil
+}
+
+func resourceIBMSchematicsWorkspaceUploadTemplate(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID string, templateID string, template *schematicsTemplateArchive) error {
+	templateRepoUploadOptions := &schematicsv1.TemplateRepoUploadOptions{}
+	templateRepoUploadOptions.SetWID(workspaceID)
+	templateRepoUploadOptions.SetTID(templateID)
+	templateRepoUploadOptions.SetFile(io.NopCloser(bytes.NewReader(template.content)))
+	templateRepoUploadOptions.SetFileContentType("application/octet-stream")
+
+	_, response, err := schematicsClient.TemplateRepoUploadWithContext(context, templateRepoUploadOptions)
+	if err != nil {
+		log.Printf("[DEBUG] TemplateRepoUploadWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("TemplateRepoUploadWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
@@ -0,0 +1,609 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+const (
+	schematicsActivityStatusCreated    = "CREATED"
+	schematicsActivityStatusPending    = "PENDING"
+	schematicsActivityStatusQueued     = "QUEUED"
+	schematicsActivityStatusInProgress = "INPROGRESS"
+	schematicsActivityStatusCompleted  = "COMPLETED"
+	schematicsActivityStatusFailed     = "FAILED"
+	schematicsActivityStatusStopped    = "STOPPED"
+	schematicsActivityStatusError      = "ERROR"
+
+	schematicsActivityLogTailLines = 100
+	schematicsActivityListLimit    = 100
+)
+
+var schematicsPlanSummaryRegexp = regexp.MustCompile(`Plan: (\d+) to add, (\d+) to change, (\d+) to destroy`)
+
+func ResourceIBMSchematicsWorkspaceApply() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsWorkspaceApplyCreate,
+		ReadContext:   resourceIBMSchematicsWorkspaceApplyRead,
+		UpdateContext: resourceIBMSchematicsWorkspaceApplyUpdate,
+		DeleteContext: resourceIBMSchematicsWorkspaceApplyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMSchematicsWorkspaceApplyRetryDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the workspace to plan and apply.",
+			},
+			"targets": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The resource addresses that the plan and apply are limited to.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, run a new plan.",
+			},
+			"auto_approve": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to run apply as soon as the plan completes.",
+			},
+			"approved_plan_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the plan that is approved for apply. Apply runs when it matches plan_id.",
+			},
+			"plan_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The activity ID of the plan job.",
+			},
+			"plan_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the plan job.",
+			},
+			"workspace_updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the workspace was last updated, recorded when the plan completed.",
+			},
+			"template_checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The checksum of the workspace template and variables, recorded when the plan completed.",
+			},
+			"resources_to_add": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of resources that the plan adds.",
+			},
+			"resources_to_change": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of resources that the plan changes.",
+			},
+			"resources_to_destroy": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of resources that the plan destroys.",
+			},
+			"apply_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The activity ID of the apply job.",
+			},
+			"apply_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the apply job.",
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsWorkspaceApplyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := schematicsWorkspaceApplyClient(d.Get("workspace_id").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	planWorkspaceCommandOptions := &schematicsv1.PlanWorkspaceCommandOptions{}
+	planWorkspaceCommandOptions.SetWID(workspaceID)
+	planWorkspaceCommandOptions.SetRefreshToken(session.Config.IAMRefreshToken)
+	if actionOptions := resourceIBMSchematicsWorkspaceApplyActionOptions(d); actionOptions != nil {
+		planWorkspaceCommandOptions.SetActionOptions(actionOptions)
+	}
+
+	planResult, response, err := schematicsClient.PlanWorkspaceCommandWithContext(context, planWorkspaceCommandOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PlanWorkspaceCommandWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PlanWorkspaceCommandWithContext failed %s\n%s", err, response))
+	}
+	planID := *planResult.Activityid
+
+	d.SetId(fmt.Sprintf("%s/%s", workspaceID, planID))
+
+	activity, planLog, err := waitForSchematicsWorkspaceActivity(context, schematicsClient, workspaceID, planID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("plan_id", planID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting plan_id: %s", err))
+	}
+	if err = d.Set("plan_status", activity.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting plan_status: %s", err))
+	}
+	if err = resourceIBMSchematicsWorkspaceApplySetPlanSummary(d, activity, planLog); err != nil {
+		return diag.FromErr(err)
+	}
+	if *activity.Status != schematicsActivityStatusCompleted {
+		return diag.FromErr(fmt.Errorf("[ERROR] Plan %s of workspace %s finished with status %s\n%s", planID, workspaceID, *activity.Status, schematicsActivityLogTail(planLog)))
+	}
+
+	workspace, err := getSchematicsWorkspaceApplyWorkspace(context, schematicsClient, workspaceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	updatedAt, checksum, err := schematicsWorkspaceApplyFingerprint(workspace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("workspace_updated_at", updatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting workspace_updated_at: %s", err))
+	}
+	if err = d.Set("template_checksum", checksum); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting template_checksum: %s", err))
+	}
+
+	if d.Get("auto_approve").(bool) || d.Get("approved_plan_id").(string) == planID {
+		if err = resourceIBMSchematicsWorkspaceApplyRun(context, d, schematicsClient, session.Config.IAMRefreshToken, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		log.Printf("[INFO] Plan %s of workspace %s is waiting for approval", planID, workspaceID)
+	}
+
+	return resourceIBMSchematicsWorkspaceApplyRead(context, d, meta)
+}
+
+func resourceIBMSchematicsWorkspaceApplyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	workspaceID := parts[0]
+	planID := parts[1]
+
+	schematicsClient, err := schematicsWorkspaceApplyClient(workspaceID, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getWorkspaceActivityOptions := &schematicsv1.GetWorkspaceActivityOptions{}
+	getWorkspaceActivityOptions.SetWID(workspaceID)
+	getWorkspaceActivityOptions.SetActivityID(planID)
+
+	activity, response, err := schematicsClient.GetWorkspaceActivityWithContext(context, getWorkspaceActivityOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetWorkspaceActivityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetWorkspaceActivityWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("workspace_id", workspaceID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting workspace_id: %s", err))
+	}
+	if err = d.Set("plan_id", planID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting plan_id: %s", err))
+	}
+	if err = d.Set("plan_status", strings.ToUpper(core.StringNilMapper(activity.Status))); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting plan_status: %s", err))
+	}
+	if _, ok := d.GetOk("resources_to_add"); !ok {
+		if err = resourceIBMSchematicsWorkspaceApplySetPlanSummary(d, activity, ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if applyID, ok := d.GetOk("apply_id"); ok {
+		getWorkspaceActivityOptions.SetActivityID(applyID.(string))
+		applyActivity, response, err := schematicsClient.GetWorkspaceActivityWithContext(context, getWorkspaceActivityOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetWorkspaceActivityWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetWorkspaceActivityWithContext failed %s\n%s", err, response))
+		}
+		if err = d.Set("apply_status", strings.ToUpper(core.StringNilMapper(applyActivity.Status))); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting apply_status: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsWorkspaceApplyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A failed apply is run again, a completed one is never repeated.
+	if _, ok := d.GetOk("apply_id"); ok && d.Get("apply_status").(string) == schematicsActivityStatusCompleted {
+		return resourceIBMSchematicsWorkspaceApplyRead(context, d, meta)
+	}
+
+	planID := d.Get("plan_id").(string)
+	approvedPlanID := d.Get("approved_plan_id").(string)
+	if approvedPlanID != "" && approvedPlanID != planID {
+		return diag.FromErr(fmt.Errorf("[ERROR] approved_plan_id %s does not match the plan %s of workspace %s", approvedPlanID, planID, d.Get("workspace_id").(string)))
+	}
+	if !d.Get("auto_approve").(bool) && approvedPlanID == "" {
+		return resourceIBMSchematicsWorkspaceApplyRead(context, d, meta)
+	}
+	if d.Get("plan_status").(string) != schematicsActivityStatusCompleted {
+		return diag.FromErr(fmt.Errorf("[ERROR] Plan %s finished with status %s and can't be applied", planID, d.Get("plan_status").(string)))
+	}
+
+	schematicsClient, err := schematicsWorkspaceApplyClient(d.Get("workspace_id").(string), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = resourceIBMSchematicsWorkspaceApplyRun(context, d, schematicsClient, session.Config.IAMRefreshToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSchematicsWorkspaceApplyRead(context, d, meta)
+}
+
+// resourceIBMSchematicsWorkspaceApplyRetryDiff plans an update when the last
+// apply didn't complete, so that the next terraform apply runs it again
+// without having to change triggers.
+func resourceIBMSchematicsWorkspaceApplyRetryDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	applyID, _ := diff.GetOk("apply_id")
+	applyStatus := diff.Get("apply_status").(string)
+	if applyID != nil && applyID.(string) != "" && applyStatus != schematicsActivityStatusCompleted {
+		return diff.SetNewComputed("apply_status")
+	}
+	return nil
+}
+
+// Schematics keeps the plan and apply jobs in the workspace history, they
+// can't be deleted. Deleting the resource doesn't destroy anything.
+func resourceIBMSchematicsWorkspaceApplyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func schematicsWorkspaceApplyClient(workspaceID string, meta interface{}) (*schematicsv1.SchematicsV1, error) {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return nil, err
+	}
+	region := strings.Split(workspaceID, ".")[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+	return schematicsClient, nil
+}
+
+func resourceIBMSchematicsWorkspaceApplyActionOptions(d *schema.ResourceData) *schematicsv1.WorkspaceActivityOptionsTemplate {
+	targets, ok := d.GetOk("targets")
+	if !ok {
+		return nil
+	}
+	return &schematicsv1.WorkspaceActivityOptionsTemplate{
+		Target: flex.ExpandStringList(targets.([]interface{})),
+	}
+}
+
+func resourceIBMSchematicsWorkspaceApplyRun(context context.Context, d *schema.ResourceData, schematicsClient *schematicsv1.SchematicsV1, refreshToken string, timeout time.Duration) error {
+	workspaceID := d.Get("workspace_id").(string)
+
+	if err := resourceIBMSchematicsWorkspaceApplyCheckUnchanged(context, d, schematicsClient); err != nil {
+		return err
+	}
+
+	applyWorkspaceCommandOptions := &schematicsv1.ApplyWorkspaceCommandOptions{}
+	applyWorkspaceCommandOptions.SetWID(workspaceID)
+	applyWorkspaceCommandOptions.SetRefreshToken(refreshToken)
+	if actionOptions := resourceIBMSchematicsWorkspaceApplyActionOptions(d); actionOptions != nil {
+		applyWorkspaceCommandOptions.SetActionOptions(actionOptions)
+	}
+
+	applyResult, response, err := schematicsClient.ApplyWorkspaceCommandWithContext(context, applyWorkspaceCommandOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ApplyWorkspaceCommandWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("ApplyWorkspaceCommandWithContext failed %s\n%s", err, response)
+	}
+	applyID := *applyResult.Activityid
+	if err = d.Set("apply_id", applyID); err != nil {
+		return fmt.Errorf("Error setting apply_id: %s", err)
+	}
+
+	activity, applyLog, err := waitForSchematicsWorkspaceActivity(context, schematicsClient, workspaceID, applyID, timeout)
+	if err != nil {
+		return err
+	}
+	// The apply job itself updates the workspace, record that so a failed
+	// apply can be run again.
+	if workspace, err := getSchematicsWorkspaceApplyWorkspace(context, schematicsClient, workspaceID); err == nil {
+		if updatedAt, _, err := schematicsWorkspaceApplyFingerprint(workspace); err == nil {
+			d.Set("workspace_updated_at", updatedAt)
+		}
+	}
+	if err = d.Set("apply_status", activity.Status); err != nil {
+		return fmt.Errorf("Error setting apply_status: %s", err)
+	}
+	if *activity.Status != schematicsActivityStatusCompleted {
+		return fmt.Errorf("[ERROR] Apply %s of workspace %s finished with status %s\n%s", applyID, workspaceID, *activity.Status, schematicsActivityLogTail(applyLog))
+	}
+	return nil
+}
+
+// resourceIBMSchematicsWorkspaceApplyCheckUnchanged makes sure that the
+// workspace is still the one that was planned. Apply plans again from the
+// current workspace, so any job or update after the plan means that what would
+// be applied isn't the approved plan.
+func resourceIBMSchematicsWorkspaceApplyCheckUnchanged(context context.Context, d *schema.ResourceData, schematicsClient *schematicsv1.SchematicsV1) error {
+	workspaceID := d.Get("workspace_id").(string)
+	planID := d.Get("plan_id").(string)
+
+	workspace, err := getSchematicsWorkspaceApplyWorkspace(context, schematicsClient, workspaceID)
+	if err != nil {
+		return err
+	}
+	updatedAt, checksum, err := schematicsWorkspaceApplyFingerprint(workspace)
+	if err != nil {
+		return err
+	}
+	if updatedAt != d.Get("workspace_updated_at").(string) {
+		return fmt.Errorf("[ERROR] Workspace %s was updated at %s after plan %s, run a new plan before applying", workspaceID, updatedAt, planID)
+	}
+	if checksum != d.Get("template_checksum").(string) {
+		return fmt.Errorf("[ERROR] The template or variables of workspace %s changed after plan %s, run a new plan before applying", workspaceID, planID)
+	}
+
+	listWorkspaceActivitiesOptions := &schematicsv1.ListWorkspaceActivitiesOptions{}
+	listWorkspaceActivitiesOptions.SetWID(workspaceID)
+	listWorkspaceActivitiesOptions.SetLimit(schematicsActivityListLimit)
+	activities, response, err := schematicsClient.ListWorkspaceActivitiesWithContext(context, listWorkspaceActivitiesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListWorkspaceActivitiesWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("ListWorkspaceActivitiesWithContext failed %s\n%s", err, response)
+	}
+	if newer := schematicsActivitiesAfter(activities.Actions, planID, d.Get("apply_id").(string)); newer != "" {
+		return fmt.Errorf("[ERROR] Job %s ran on workspace %s after plan %s, run a new plan before applying", newer, workspaceID, planID)
+	}
+	return nil
+}
+
+// schematicsActivitiesAfter returns the ID of a job that was performed after
+// the plan, other than the plan itself and a previous failed apply of it.
+func schematicsActivitiesAfter(activities []schematicsv1.WorkspaceActivity, planID string, applyID string) string {
+	var plannedAt time.Time
+	for _, activity := range activities {
+		if core.StringNilMapper(activity.ActionID) == planID && activity.PerformedAt != nil {
+			plannedAt = time.Time(*activity.PerformedAt)
+		}
+	}
+	for _, activity := range activities {
+		id := core.StringNilMapper(activity.ActionID)
+		if id == planID || (applyID != "" && id == applyID) || activity.PerformedAt == nil {
+			continue
+		}
+		if time.Time(*activity.PerformedAt).After(plannedAt) {
+			return id
+		}
+	}
+	return ""
+}
+
+func getSchematicsWorkspaceApplyWorkspace(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID string) (*schematicsv1.WorkspaceResponse, error) {
+	getWorkspaceOptions := &schematicsv1.GetWorkspaceOptions{}
+	getWorkspaceOptions.SetWID(workspaceID)
+	workspace, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response)
+	}
+	return workspace, nil
+}
+
+// schematicsWorkspaceApplyFingerprint returns the update time of the workspace
+// and a checksum of its template source and variables.
+func schematicsWorkspaceApplyFingerprint(workspace *schematicsv1.WorkspaceResponse) (string, string, error) {
+	updatedAt := ""
+	if workspace.UpdatedAt != nil {
+		updatedAt = workspace.UpdatedAt.String()
+	}
+	template, err := json.Marshal(struct {
+		TemplateRepo *schematicsv1.TemplateRepoResponse        `json:"template_repo"`
+		TemplateData []schematicsv1.TemplateSourceDataResponse `json:"template_data"`
+	}{workspace.TemplateRepo, workspace.TemplateData})
+	if err != nil {
+		return "", "", fmt.Errorf("[ERROR] Error computing the template checksum of workspace %s: %s", core.StringNilMapper(workspace.ID), err)
+	}
+	checksum := sha256.Sum256(template)
+	return updatedAt, hex.EncodeToString(checksum[:]), nil
+}
+
+// resourceIBMSchematicsWorkspaceApplySetPlanSummary sets the resource counts
+// from the log summary of the plan, falling back to the "Plan:" line of the
+// plan log for templates that don't report one.
+func resourceIBMSchematicsWorkspaceApplySetPlanSummary(d *schema.ResourceData, activity *schematicsv1.WorkspaceActivity, planLog string) error {
+	var add, change, destroy int64
+	found := false
+	for _, template := range activity.Templates {
+		if template.LogSummary == nil || template.LogSummary.ResourcesAdded == nil {
+			continue
+		}
+		add += *template.LogSummary.ResourcesAdded
+		if template.LogSummary.ResourcesModified != nil {
+			change += *template.LogSummary.ResourcesModified
+		}
+		if template.LogSummary.ResourcesDestroyed != nil {
+			destroy += *template.LogSummary.ResourcesDestroyed
+		}
+		found = true
+	}
+	if !found {
+		for _, match := range schematicsPlanSummaryRegexp.FindAllStringSubmatch(planLog, -1) {
+			a, _ := strconv.ParseInt(match[1], 10, 64)
+			c, _ := strconv.ParseInt(match[2], 10, 64)
+			r, _ := strconv.ParseInt(match[3], 10, 64)
+			add, change, destroy = add+a, change+c, destroy+r
+		}
+	}
+
+	if err := d.Set("resources_to_add", add); err != nil {
+		return fmt.Errorf("Error setting resources_to_add: %s", err)
+	}
+	if err := d.Set("resources_to_change", change); err != nil {
+		return fmt.Errorf("Error setting resources_to_change: %s", err)
+	}
+	if err := d.Set("resources_to_destroy", destroy); err != nil {
+		return fmt.Errorf("Error setting resources_to_destroy: %s", err)
+	}
+	return nil
+}
+
+// waitForSchematicsWorkspaceActivity waits until the workspace job finishes
+// and returns its log. New log lines are written to the provider log while
+// the job runs.
+func waitForSchematicsWorkspaceActivity(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID string, activityID string, timeout time.Duration) (*schematicsv1.WorkspaceActivity, string, error) {
+	logOffsets := map[string]int{}
+	tailLog := func(activity *schematicsv1.WorkspaceActivity) string {
+		var fullLog strings.Builder
+		for _, template := range activity.Templates {
+			if template.TemplateID == nil {
+				continue
+			}
+			templateLog, err := getSchematicsTemplateActivityLog(context, schematicsClient, workspaceID, *template.TemplateID, activityID)
+			if err != nil {
+				log.Printf("[WARN] Unable to retrieve the log of job %s: %s", activityID, err)
+				continue
+			}
+			if offset := logOffsets[*template.TemplateID]; len(templateLog) > offset {
+				for _, line := range strings.Split(strings.TrimRight(templateLog[offset:], "\n"), "\n") {
+					log.Printf("[INFO] [%s] %s", activityID, line)
+				}
+				logOffsets[*template.TemplateID] = len(templateLog)
+			}
+			fullLog.WriteString(templateLog)
+		}
+		return fullLog.String()
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			schematicsActivityStatusCreated,
+			schematicsActivityStatusPending,
+			schematicsActivityStatusQueued,
+			schematicsActivityStatusInProgress,
+		},
+		Target: []string{
+			schematicsActivityStatusCompleted,
+			schematicsActivityStatusFailed,
+			schematicsActivityStatusStopped,
+			schematicsActivityStatusError,
+		},
+		Refresh: func() (interface{}, string, error) {
+			getWorkspaceActivityOptions := &schematicsv1.GetWorkspaceActivityOptions{}
+			getWorkspaceActivityOptions.SetWID(workspaceID)
+			getWorkspaceActivityOptions.SetActivityID(activityID)
+			activity, response, err := schematicsClient.GetWorkspaceActivityWithContext(context, getWorkspaceActivityOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetWorkspaceActivityWithContext failed %s\n%s", err, response)
+			}
+			tailLog(activity)
+			return activity, strings.ToUpper(core.StringNilMapper(activity.Status)), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error waiting for job %s of workspace %s: %s", activityID, workspaceID, err)
+	}
+	activity := result.(*schematicsv1.WorkspaceActivity)
+	activity.Status = core.StringPtr(strings.ToUpper(core.StringNilMapper(activity.Status)))
+	return activity, tailLog(activity), nil
+}
+
+func getSchematicsTemplateActivityLog(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID string, templateID string, activityID string) (string, error) {
+	getTemplateActivityLogOptions := &schematicsv1.GetTemplateActivityLogOptions{}
+	getTemplateActivityLogOptions.SetWID(workspaceID)
+	getTemplateActivityLogOptions.SetTID(templateID)
+	getTemplateActivityLogOptions.SetActivityID(activityID)
+	getTemplateActivityLogOptions.SetLogTfCmd(true)
+	getTemplateActivityLogOptions.SetLogTfPrefix(true)
+
+	templateLog, response, err := schematicsClient.GetTemplateActivityLogWithContext(context, getTemplateActivityLogOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetTemplateActivityLogWithContext failed %s\n%s", err, response)
+	}
+	return core.StringNilMapper(templateLog), nil
+}
+
+// schematicsActivityLogTail keeps the last lines of a job log for error messages.
+func schematicsActivityLogTail(activityLog string) string {
+	lines := strings.Split(strings.TrimRight(activityLog, "\n"), "\n")
+	if len(lines) > schematicsActivityLogTailLines {
+		lines = lines[len(lines)-schematicsActivityLogTailLines:]
+	}
+	return strings.Join(lines, "\n")
+}
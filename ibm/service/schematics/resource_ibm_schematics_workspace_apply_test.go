@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsWorkspaceApplyBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMSchematicsWorkspaceApplyConfig(acc.WorkspaceID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_apply.schematics_workspace_apply", "plan_id"),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_apply.schematics_workspace_apply", "plan_status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_apply.schematics_workspace_apply", "resources_to_add"),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_apply.schematics_workspace_apply", "apply_id", ""),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMSchematicsWorkspaceApplyConfig(acc.WorkspaceID, "auto_approve = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_apply.schematics_workspace_apply", "apply_id"),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_apply.schematics_workspace_apply", "apply_status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceApplyConfig(workspaceID string, approval string) string {
+	return fmt.Sprintf(`
+		resource "ibm_schematics_workspace_apply" "schematics_workspace_apply" {
+			workspace_id = "%s"
+			%s
+		}
+	`, workspaceID, approval)
+}
@@ -5,6 +5,8 @@ package schematics_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -109,6 +111,64 @@ func TestAccIBMSchematicsWorkspaceAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMSchematicsWorkspaceTemplateSourceDir(t *testing.T) {
+	var conf schematicsv1.WorkspaceResponse
+	var templateHash string
+	name := fmt.Sprintf("tf-acc-test-schematics-%d", acctest.RandIntRange(10, 100))
+	sourceDir := t.TempDir()
+	writeTemplate := func(content string) {
+		if err := ioutil.WriteFile(filepath.Join(sourceDir, "main.tf"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeTemplate(`output "greeting" { value = "hello" }`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMSchematicsWorkspaceConfigTemplateSourceDir(name, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsWorkspaceExists("ibm_schematics_workspace.schematics_workspace", conf),
+					resource.TestCheckResourceAttrWith("ibm_schematics_workspace.schematics_workspace", "template_hash", func(value string) error {
+						templateHash = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.schematics_workspace", "template_git_has_uploadedgitrepotar", "true"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() { writeTemplate(`output "greeting" { value = "hello again" }`) },
+				Config:    testAccCheckIBMSchematicsWorkspaceConfigTemplateSourceDir(name, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("ibm_schematics_workspace.schematics_workspace", "template_hash", func(value string) error {
+						if value == templateHash {
+							return fmt.Errorf("template_hash was not updated after the template changed")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceConfigTemplateSourceDir(name string, sourceDir string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_workspace" "schematics_workspace" {
+			description = "tf-acc-test-schematics"
+			name = "%s"
+			location = "us-east"
+			resource_group = "default"
+			template_type = "terraform_v1.0"
+			template_source_dir = "%s"
+		}
+	`, name, sourceDir)
+}
+
 func testAccCheckIBMSchematicsWorkspaceConfigBasic() string {
 	return `
 
@@ -0,0 +1,347 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/api/certificatemanager"
+	"github.com/IBM-Cloud/bluemix-go/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+const (
+	certificateMigrationMigrated = "migrated"
+	certificateMigrationFailed   = "failed"
+	certificateMigrationSkipped  = "skipped"
+)
+
+func ResourceIbmSmCertificateManagerMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmCertificateManagerMigrationCreate,
+		ReadContext:   resourceIbmSmCertificateManagerMigrationRead,
+		UpdateContext: resourceIbmSmCertificateManagerMigrationUpdate,
+		DeleteContext: resourceIbmSmCertificateManagerMigrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"certificate_manager_instance_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Certificate Manager instance to migrate the certificates from.",
+			},
+			"certificate_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the Certificate Manager certificates to migrate. All certificates of the instance are migrated when not set.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "default",
+				Description: "A v4 UUID identifier, or `default` secret group, to create the certificates in.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels added to every migrated certificate.",
+			},
+			"public_certificate_ca": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"public_certificate_dns"},
+				Description:  "The name of the certificate authority configuration. When set, ordered certificates with auto-renew enabled are ordered again as public certificates with automatic rotation.",
+			},
+			"public_certificate_dns": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"public_certificate_ca"},
+				Description:  "The name of the DNS provider configuration used to order public certificates.",
+			},
+			"certificates": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The result of the migration for every certificate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_manager_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the certificate in Certificate Manager.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the secret in Secrets Manager.",
+						},
+						"secret_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the secret created in Secrets Manager.",
+						},
+						"secret_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the secret created in Secrets Manager, `imported_cert` or `public_cert`.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the migration of the certificate: `migrated`, `skipped` or `failed`.",
+						},
+						"reason": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the certificate was not migrated, or what was not preserved.",
+						},
+					},
+				},
+			},
+			"migrated_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of migrated certificates.",
+			},
+			"failed_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of certificates that could not be migrated.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmCertificateManagerMigrationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, d)
+
+	cmInstanceID := d.Get("certificate_manager_instance_id").(string)
+	certificates, err := cmService.Certificate().ListCertificates(cmInstanceID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("ListCertificates failed for Certificate Manager instance %s: %s", cmInstanceID, err))
+	}
+
+	selected := map[string]bool{}
+	if v, ok := d.GetOk("certificate_ids"); ok {
+		for _, id := range flex.ExpandStringList(v.(*schema.Set).List()) {
+			selected[id] = true
+		}
+	}
+
+	results := make([]map[string]interface{}, 0, len(certificates))
+	migrated, failed := 0, 0
+	for _, certificate := range certificates {
+		if len(selected) > 0 && !selected[certificate.ID] {
+			continue
+		}
+		delete(selected, certificate.ID)
+
+		result := migrateCertificateManagerCertificate(context, d, cmService.Certificate(), secretsManagerClient, certificate)
+		switch result["status"] {
+		case certificateMigrationMigrated:
+			migrated++
+		case certificateMigrationFailed:
+			failed++
+		}
+		results = append(results, result)
+	}
+	for id := range selected {
+		results = append(results, map[string]interface{}{
+			"certificate_manager_id": id,
+			"status":                 certificateMigrationFailed,
+			"reason":                 fmt.Sprintf("certificate not found in Certificate Manager instance %s", cmInstanceID),
+		})
+		failed++
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("instance_id").(string), cmInstanceID))
+	if err = d.Set("certificates", results); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting certificates: %s", err))
+	}
+	if err = d.Set("migrated_count", migrated); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting migrated_count: %s", err))
+	}
+	if err = d.Set("failed_count", failed); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting failed_count: %s", err))
+	}
+
+	if failed > 0 {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d certificates could not be migrated", failed),
+			Detail:   "See the certificates attribute for the reason of every failed certificate.",
+		}}
+	}
+	return nil
+}
+
+// migrateCertificateManagerCertificate copies one certificate to Secrets Manager and
+// returns the entry of the certificates attribute describing the outcome
+func migrateCertificateManagerCertificate(context context.Context, d *schema.ResourceData, cmCertificates certificatemanager.Certificate, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, certificate models.CertificateInfo) map[string]interface{} {
+	name := certificateManagerSecretName(certificate.Name)
+	if name == "" {
+		name = certificateManagerSecretName(certificate.ID[strings.LastIndex(certificate.ID, ":")+1:])
+	}
+	result := map[string]interface{}{
+		"certificate_manager_id": certificate.ID,
+		"name":                   name,
+	}
+
+	if certificate.Status != "valid" {
+		result["status"] = certificateMigrationSkipped
+		result["reason"] = fmt.Sprintf("certificate is %s", certificate.Status)
+		return result
+	}
+
+	labels := flex.ExpandStringList(d.Get("labels").([]interface{}))
+	customMetadata := map[string]interface{}{
+		"certificate_manager_id": certificate.ID,
+	}
+
+	autoRenew := !certificate.Imported && certificate.OrderPolicy.AutoRenewEnabled
+	_, orderPublic := d.GetOk("public_certificate_ca")
+
+	var prototype secretsmanagerv2.SecretPrototypeIntf
+	var reason string
+	if autoRenew && orderPublic {
+		if len(certificate.Domains) == 0 {
+			result["status"] = certificateMigrationFailed
+			result["reason"] = "certificate has no domains to order a public certificate for"
+			return result
+		}
+		model := &secretsmanagerv2.PublicCertificatePrototype{
+			SecretType:     core.StringPtr("public_cert"),
+			Name:           core.StringPtr(name),
+			SecretGroupID:  core.StringPtr(d.Get("secret_group_id").(string)),
+			Labels:         labels,
+			CommonName:     core.StringPtr(certificate.Domains[0]),
+			AltNames:       certificate.Domains[1:],
+			Ca:             core.StringPtr(d.Get("public_certificate_ca").(string)),
+			Dns:            core.StringPtr(d.Get("public_certificate_dns").(string)),
+			CustomMetadata: customMetadata,
+			Rotation: &secretsmanagerv2.PublicCertificateRotationPolicy{
+				AutoRotate: core.BoolPtr(true),
+				RotateKeys: core.BoolPtr(certificate.RotateKeys),
+			},
+		}
+		if certificate.Description != "" {
+			model.Description = core.StringPtr(certificate.Description)
+		}
+		if keyAlgorithm, ok := certificateManagerKeyAlgorithms[certificate.KeyAlgorithm]; ok {
+			model.KeyAlgorithm = core.StringPtr(keyAlgorithm)
+		}
+		prototype = model
+		result["secret_type"] = "public_cert"
+	} else {
+		data, err := cmCertificates.GetCertData(certificate.ID)
+		if err != nil {
+			result["status"] = certificateMigrationFailed
+			result["reason"] = fmt.Sprintf("GetCertData failed: %s", err)
+			return result
+		}
+		if data.Data == nil || data.Data.Content == "" {
+			result["status"] = certificateMigrationFailed
+			result["reason"] = "certificate content is not available"
+			return result
+		}
+		model := &secretsmanagerv2.ImportedCertificatePrototype{
+			SecretType:     core.StringPtr("imported_cert"),
+			Name:           core.StringPtr(name),
+			SecretGroupID:  core.StringPtr(d.Get("secret_group_id").(string)),
+			Labels:         labels,
+			Certificate:    core.StringPtr(data.Data.Content),
+			CustomMetadata: customMetadata,
+		}
+		if certificate.Description != "" {
+			model.Description = core.StringPtr(certificate.Description)
+		}
+		if data.Data.Privatekey != "" {
+			model.PrivateKey = core.StringPtr(data.Data.Privatekey)
+		}
+		if data.Data.IntermediateCertificate != "" {
+			model.Intermediate = core.StringPtr(data.Data.IntermediateCertificate)
+		}
+		prototype = model
+		result["secret_type"] = "imported_cert"
+		if autoRenew {
+			reason = "auto-renew is not preserved for imported certificates, set public_certificate_ca and public_certificate_dns to order it as a public certificate"
+		}
+	}
+
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+	createSecretOptions.SetSecretPrototype(prototype)
+	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretWithContext failed for certificate %s %s\n%s", certificate.ID, err, response)
+		result["status"] = certificateMigrationFailed
+		result["reason"] = fmt.Sprintf("CreateSecretWithContext failed: %s", err)
+		return result
+	}
+
+	switch secret := secretIntf.(type) {
+	case *secretsmanagerv2.ImportedCertificate:
+		result["secret_id"] = *secret.ID
+	case *secretsmanagerv2.PublicCertificate:
+		result["secret_id"] = *secret.ID
+	}
+	result["status"] = certificateMigrationMigrated
+	result["reason"] = reason
+	return result
+}
+
+func resourceIbmSmCertificateManagerMigrationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The migration is a one-time operation, its result is kept in the state
+	return nil
+}
+
+func resourceIbmSmCertificateManagerMigrationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only endpoint_type can change, it is used by later operations and needs no API call
+	return resourceIbmSmCertificateManagerMigrationRead(context, d, meta)
+}
+
+func resourceIbmSmCertificateManagerMigrationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The migrated secrets are not deleted, they are managed with ibm_sm_imported_certificate
+	// and ibm_sm_public_certificate once imported
+	d.SetId("")
+	return nil
+}
+
+// key algorithms of Certificate Manager and their Secrets Manager equivalent
+var certificateManagerKeyAlgorithms = map[string]string{
+	"rsaEncryption 2048 bit": "RSA2048",
+	"rsaEncryption 4096 bit": "RSA4096",
+	"id-ecPublicKey 256 bit": "ECDSA256",
+	"id-ecPublicKey 384 bit": "ECDSA384",
+}
+
+var secretNameInvalidCharacters = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// certificateManagerSecretName turns a Certificate Manager certificate name into a valid secret name
+func certificateManagerSecretName(name string) string {
+	return strings.Trim(secretNameInvalidCharacters.ReplaceAllString(name, "-"), "-.")
+}
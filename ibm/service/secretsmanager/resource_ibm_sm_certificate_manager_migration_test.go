@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmCertificateManagerMigrationBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmCertificateManagerMigrationConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_certificate_manager_migration.sm_certificate_manager_migration", "migrated_count"),
+					resource.TestCheckResourceAttr("ibm_sm_certificate_manager_migration.sm_certificate_manager_migration", "failed_count", "0"),
+					resource.TestCheckResourceAttrSet("ibm_sm_certificate_manager_migration.sm_certificate_manager_migration", "certificates.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmCertificateManagerMigrationConfigBasic() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_certificate_manager_migration" "sm_certificate_manager_migration" {
+			instance_id   = "%s"
+			region        = "%s"
+			certificate_manager_instance_id = "%s"
+			secret_group_id = "default"
+			labels = ["migrated-from-certificate-manager"]
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerCertificateManagerInstanceCrn)
+}
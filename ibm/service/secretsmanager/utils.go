@@ -11,15 +11,25 @@ import (
 
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) *secretsmanagerv2.SecretsManagerV2 {
-	baseUrl := originalClient.Service.GetServiceURL()
 	instanceId := d.Get("instance_id").(string)
+	var region, endpointType string
+	if _, ok := d.GetOk("region"); ok {
+		region = d.Get("region").(string)
+	}
+	if _, ok := d.GetOk("endpoint_type"); ok {
+		endpointType = d.Get("endpoint_type").(string)
+		log.Printf("[DEBUG] Found endpoint type field")
+	}
+	return GetClientWithInstanceEndpoint(originalClient, instanceId, region, endpointType)
+}
+
+// GetClientWithInstanceEndpoint clones the base secrets manager client and sets the API
+// endpoint of the instance. An empty region or endpoint type is taken from the base URL.
+func GetClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, instanceId, region, endpointType string) *secretsmanagerv2.SecretsManagerV2 {
+	baseUrl := originalClient.Service.GetServiceURL()
 
 	// find the region
-	var region string
-	_, ok := d.GetOk("region")
-	if ok {
-		region = d.Get("region").(string)
-	} else {
+	if region == "" {
 		// extract region from base URL (provider config)
 		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
 		u := strings.Replace(baseUrl, "private.", "", 1)
@@ -29,12 +39,7 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	log.Printf("[DEBUG] Secret Manager base URL: %s", baseUrl)
 
 	// find the endpoint type
-	var endpointType string
-	_, ok = d.GetOk("endpoint_type")
-	if ok {
-		endpointType = d.Get("endpoint_type").(string)
-		log.Printf("[DEBUG] Found endpoint type field")
-	} else {
+	if endpointType == "" {
 		if strings.Contains(baseUrl, "private.") {
 			endpointType = "private"
 		} else {
@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tgBaseRouteReport           = "base_route_report"
+	tgAddedRoutes               = "added_routes"
+	tgMissingRoutes             = "missing_routes"
+	tgHasMissingRoutes          = "has_missing_routes"
+	tgHasOverlappingRoutes      = "has_overlapping_routes"
+	tgNewOverlappingRoutes      = "new_overlapping_routes"
+	tgRouteReportConnectionName = "connection_name"
+)
+
+func DataSourceIBMTransitGatewayRouteReportDiff() *schema.Resource {
+	routeSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			tgConnectionId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The connection the route is used by",
+			},
+			tgRouteReportConnectionName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the connection the route is used by",
+			},
+			tgRouteReportConnectionRoutePrefix: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The prefix of the route",
+			},
+		},
+	}
+	overlappingRouteSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			tgRouteReportOverlappingRoutesDetail: {
+				Type:        schema.TypeList,
+				Description: "Collection of transit gateway overlapping route's details",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgConnectionId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteReportOverlappingPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceIBMTransitGatewayRouteReportDiffRead,
+		Schema: map[string]*schema.Schema{
+			tgGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit Gateway identifier",
+			},
+			tgBaseRouteReport: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The identifier of the Transit Gateway Route Report to compare against, usually the older report",
+			},
+			tgRouteReport: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The identifier of the Transit Gateway Route Report compared with the base report, usually the newer report",
+			},
+			tgAddedRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Connection routes in the route report that are not in the base route report",
+				Elem:        routeSchema,
+			},
+			tgMissingRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Connection routes in the base route report that are missing from the route report",
+				Elem:        routeSchema,
+			},
+			tgRouteReportOverlappingRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of transit gateway overlapping routes of the route report",
+				Elem:        overlappingRouteSchema,
+			},
+			tgNewOverlappingRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Overlapping routes of the route report that do not overlap in the base route report",
+				Elem:        overlappingRouteSchema,
+			},
+			tgHasMissingRoutes: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether any route of the base route report is missing from the route report",
+			},
+			tgHasOverlappingRoutes: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the route report has overlapping routes",
+			},
+		},
+	}
+}
+
+func dataSourceIBMTransitGatewayRouteReportDiffRead(d *schema.ResourceData, meta interface{}) error {
+
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	gatewayId := d.Get(tgGatewayId).(string)
+	baseRouteReport, err := getTransitGatewayCompletedRouteReport(client, gatewayId, d.Get(tgBaseRouteReport).(string))
+	if err != nil {
+		return err
+	}
+	routeReport, err := getTransitGatewayCompletedRouteReport(client, gatewayId, d.Get(tgRouteReport).(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", gatewayId, *baseRouteReport.ID, *routeReport.ID))
+
+	missingRoutes := diffTransitGatewayRouteReportRoutes(baseRouteReport, routeReport)
+	d.Set(tgAddedRoutes, diffTransitGatewayRouteReportRoutes(routeReport, baseRouteReport))
+	d.Set(tgMissingRoutes, missingRoutes)
+	d.Set(tgHasMissingRoutes, len(missingRoutes) > 0)
+
+	baseOverlaps := map[string]bool{}
+	for _, overlap := range baseRouteReport.OverlappingRoutes {
+		baseOverlaps[transitGatewayOverlapKey(overlap)] = true
+	}
+	overlappingRoutes := make([]map[string]interface{}, 0)
+	newOverlappingRoutes := make([]map[string]interface{}, 0)
+	for _, overlap := range routeReport.OverlappingRoutes {
+		routes := make([]map[string]interface{}, 0)
+		for _, routeDetail := range overlap.Routes {
+			tgRoutesDetail := map[string]interface{}{}
+			if routeDetail.ConnectionID != nil {
+				tgRoutesDetail[tgConnectionId] = *routeDetail.ConnectionID
+			}
+			if routeDetail.Prefix != nil {
+				tgRoutesDetail[tgRouteReportOverlappingPrefix] = *routeDetail.Prefix
+			}
+			routes = append(routes, tgRoutesDetail)
+		}
+		tgRoutes := map[string]interface{}{
+			tgRouteReportOverlappingRoutesDetail: routes,
+		}
+		overlappingRoutes = append(overlappingRoutes, tgRoutes)
+		if !baseOverlaps[transitGatewayOverlapKey(overlap)] {
+			newOverlappingRoutes = append(newOverlappingRoutes, tgRoutes)
+		}
+	}
+	d.Set(tgRouteReportOverlappingRoutes, overlappingRoutes)
+	d.Set(tgNewOverlappingRoutes, newOverlappingRoutes)
+	d.Set(tgHasOverlappingRoutes, len(overlappingRoutes) > 0)
+
+	return nil
+}
+
+// getTransitGatewayCompletedRouteReport gets a route report and fails if the
+// report is still being generated, as its routes would be incomplete.
+func getTransitGatewayCompletedRouteReport(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, routeReportId string) (*transitgatewayapisv1.RouteReport, error) {
+	getTransitGatewayRouteReportOptionsModel := &transitgatewayapisv1.GetTransitGatewayRouteReportOptions{}
+	getTransitGatewayRouteReportOptionsModel.SetTransitGatewayID(gatewayId)
+	getTransitGatewayRouteReportOptionsModel.SetID(routeReportId)
+	routeReport, response, err := client.GetTransitGatewayRouteReport(getTransitGatewayRouteReportOptionsModel)
+	if err != nil {
+		return nil, fmt.Errorf("Error while retrieving transit gateway route report %s\n%s", err, response)
+	}
+	if routeReport.Status == nil || *routeReport.Status != isTransitGatewayRouteReportDone {
+		return nil, fmt.Errorf("[ERROR] Transit gateway route report %s is not complete yet", routeReportId)
+	}
+	return routeReport, nil
+}
+
+// diffTransitGatewayRouteReportRoutes returns the connection routes of report
+// that are not in other. Routes are identified by connection and prefix.
+func diffTransitGatewayRouteReportRoutes(report, other *transitgatewayapisv1.RouteReport) []map[string]interface{} {
+	otherRoutes := map[string]bool{}
+	for _, connection := range other.Connections {
+		for _, route := range connection.Routes {
+			if connection.ID != nil && route.Prefix != nil {
+				otherRoutes[*connection.ID+"/"+*route.Prefix] = true
+			}
+		}
+	}
+
+	routes := make([]map[string]interface{}, 0)
+	for _, connection := range report.Connections {
+		if connection.ID == nil {
+			continue
+		}
+		for _, route := range connection.Routes {
+			if route.Prefix == nil || otherRoutes[*connection.ID+"/"+*route.Prefix] {
+				continue
+			}
+			tgRoute := map[string]interface{}{
+				tgConnectionId:                     *connection.ID,
+				tgRouteReportConnectionRoutePrefix: *route.Prefix,
+			}
+			if connection.Name != nil {
+				tgRoute[tgRouteReportConnectionName] = *connection.Name
+			}
+			routes = append(routes, tgRoute)
+		}
+	}
+	return routes
+}
+
+// transitGatewayOverlapKey identifies a group of overlapping routes
+// independently of the order of its routes.
+func transitGatewayOverlapKey(overlap transitgatewayapisv1.RouteReportOverlappingRouteGroup) string {
+	keys := make([]string, 0, len(overlap.Routes))
+	for _, route := range overlap.Routes {
+		if route.ConnectionID != nil && route.Prefix != nil {
+			keys = append(keys, *route.ConnectionID+"/"+*route.Prefix)
+		}
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMTransitGatewayRouteReportDiffDataSource_basic(t *testing.T) {
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+	location := "us-south"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayRouteReportDiffDataSourceConfig(gatewayname, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_tg_route_report_diff.test_tg_route_report_diff", "missing_routes.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_report_diff.test_tg_route_report_diff", "has_missing_routes", "false"),
+					resource.TestCheckResourceAttrSet("data.ibm_tg_route_report_diff.test_tg_route_report_diff", "has_overlapping_routes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayRouteReportDiffDataSourceConfig(gatewayname, location string) string {
+	return fmt.Sprintf(`
+	resource "ibm_tg_gateway" "test_tg_gateway" {
+		name="%s"
+		location="%s"
+		global=true
+	}
+
+	resource "ibm_tg_route_report" "test_tg_base_route" {
+		gateway = ibm_tg_gateway.test_tg_gateway.id
+	}
+
+	resource "ibm_tg_route_report" "test_tg_route" {
+		depends_on = [ibm_tg_route_report.test_tg_base_route]
+		gateway = ibm_tg_gateway.test_tg_gateway.id
+	}
+
+	data "ibm_tg_route_report_diff" "test_tg_route_report_diff" {
+		gateway = ibm_tg_gateway.test_tg_gateway.id
+		base_route_report = ibm_tg_route_report.test_tg_base_route.route_report_id
+		route_report = ibm_tg_route_report.test_tg_route.route_report_id
+	}
+	`, gatewayname, location)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,6 +40,10 @@ const (
 	tgRemoteTunnelIp                    = "remote_tunnel_ip"
 	tgZone                              = "zone"
 	tgMtu                               = "mtu"
+	tgTunnels                           = "tunnels"
+	tgTunnelId                          = "tunnel_id"
+	tgNetworkTypeRedundantGre           = "redundant_gre"
+	isTransitGatewayTunnelDeleted       = "deleted"
 )
 
 func ResourceIBMTransitGatewayConnection() *schema.Resource {
@@ -73,7 +78,7 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.InvokeValidator("ibm_tg_connection", tgNetworkType),
-				Description:  "Defines what type of network is connected via this connection. Allowable values (classic,directlink,vpc,gre_tunnel,unbound_gre_tunnel)",
+				Description:  "Defines what type of network is connected via this connection. Allowable values (classic,directlink,vpc,gre_tunnel,unbound_gre_tunnel,redundant_gre)",
 			},
 			tgName: {
 				Type:         schema.TypeString,
@@ -107,7 +112,7 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The type of network the unbound gre tunnel is targeting. This field is required for network type 'unbound_gre_tunnel'.",
+				Description: "The type of network the unbound gre tunnel is targeting. This field is required for network type 'unbound_gre_tunnel' and 'redundant_gre'.",
 			},
 			tgLocalGatewayIp: {
 				Type:        schema.TypeString,
@@ -151,6 +156,82 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 				ForceNew:    true,
 				Description: "Location of GRE tunnel. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
 			},
+			tgTunnels: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The GRE tunnels of the connection. This field is required for and only applies to network type 'redundant_gre' connections. Tunnels are added, renamed and removed in place.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgTunnelId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The GRE tunnel identifier",
+						},
+						tgName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_tg_connection", tgName),
+							Description:  "The user-defined name for this tunnel",
+						},
+						tgLocalGatewayIp: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The local gateway IP address",
+						},
+						tgLocalTunnelIp: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The local tunnel IP address",
+						},
+						tgRemoteBgpAsn: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The remote network BGP ASN, generated for the tunnel if not specified",
+						},
+						tgRemoteGatewayIp: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The remote gateway IP address",
+						},
+						tgRemoteTunnelIp: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The remote tunnel IP address",
+						},
+						tgZone: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Location of the GRE tunnel",
+						},
+						tgLocalBgpAsn: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The local network BGP ASN",
+						},
+						tgMtu: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "GRE tunnel MTU",
+						},
+						tgStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The configuration status of the tunnel. Possible values: [attached,failed,pending,deleting,detaching,detached]",
+						},
+						tgCreatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that this tunnel was created",
+						},
+						tgUpdatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that this tunnel was last updated",
+						},
+					},
+				},
+			},
 			tgCreatedAt: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -182,7 +263,7 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator {
 
 	validateSchema := make([]validate.ValidateSchema, 0)
-	networkType := "classic, directlink, vpc, gre_tunnel, unbound_gre_tunnel"
+	networkType := "classic, directlink, vpc, gre_tunnel, unbound_gre_tunnel, redundant_gre"
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 tgNetworkType,
@@ -265,7 +346,23 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		createTransitGatewayConnectionOptions.SetZone(zoneIdentity)
 	}
 
-	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	_, hasTunnels := d.GetOk(tgTunnels)
+	if networkType == tgNetworkTypeRedundantGre && !hasTunnels {
+		return fmt.Errorf("[ERROR] At least one tunnel is required for network type %s", tgNetworkTypeRedundantGre)
+	}
+	if networkType != tgNetworkTypeRedundantGre && hasTunnels {
+		return fmt.Errorf("[ERROR] The tunnels field only applies to network type %s", tgNetworkTypeRedundantGre)
+	}
+
+	var tgConnections *transitgatewayapisv1.TransitGatewayConnectionCust
+	var response *core.DetailedResponse
+	if networkType == tgNetworkTypeRedundantGre {
+		// The SDK connection options have no tunnels, so redundant GRE
+		// connections are created with the tunnels in the request body.
+		tgConnections, response, err = createTransitGatewayRedundantGreConnection(client, createTransitGatewayConnectionOptions, expandTransitGatewayTunnels(d.Get(tgTunnels).([]interface{})))
+	} else {
+		tgConnections, response, err = client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response)
 	}
@@ -375,6 +472,14 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	}
 	d.Set(tgConnectionId, *instance.ID)
 	d.Set(tgGatewayId, gatewayId)
+	if instance.NetworkType != nil && *instance.NetworkType == tgNetworkTypeRedundantGre {
+		tunnels := &tgRedundantGreTunnelCollection{}
+		response, err := transitGatewayTunnelRequest(client, core.GET, gatewayId, ID, "", nil, tunnels)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s) tunnels: %s\n%s", ID, err, response)
+		}
+		d.Set(tgTunnels, flattenTransitGatewayTunnels(d.Get(tgTunnels).([]interface{}), tunnels.Tunnels))
+	}
 	getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 		ID: &gatewayId,
 	}
@@ -401,6 +506,16 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 	gatewayId := parts[0]
 	ID := parts[1]
 
+	if d.HasChange(tgTunnels) {
+		networkType := d.Get(tgNetworkType).(string)
+		if networkType != tgNetworkTypeRedundantGre {
+			return fmt.Errorf("[ERROR] The tunnels field only applies to network type %s", tgNetworkTypeRedundantGre)
+		}
+		if len(d.Get(tgTunnels).([]interface{})) == 0 {
+			return fmt.Errorf("[ERROR] At least one tunnel is required for network type %s", tgNetworkTypeRedundantGre)
+		}
+	}
+
 	getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{
 		ID: &ID,
 	}
@@ -426,6 +541,13 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
 	}
 
+	if d.HasChange(tgTunnels) {
+		err = updateTransitGatewayTunnels(d, client, gatewayId, ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMTransitGatewayConnectionRead(d, meta)
 }
 
@@ -536,3 +658,352 @@ func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta inte
 
 	return true, nil
 }
+
+// tgRedundantGreTunnel is a tunnel of a redundant GRE connection, which the
+// transit gateway SDK does not model.
+type tgRedundantGreTunnel struct {
+	ID              *string                            `json:"id,omitempty"`
+	Name            *string                            `json:"name,omitempty"`
+	LocalBgpAsn     *int64                             `json:"local_bgp_asn,omitempty"`
+	LocalGatewayIp  *string                            `json:"local_gateway_ip,omitempty"`
+	LocalTunnelIp   *string                            `json:"local_tunnel_ip,omitempty"`
+	Mtu             *int64                             `json:"mtu,omitempty"`
+	RemoteBgpAsn    *int64                             `json:"remote_bgp_asn,omitempty"`
+	RemoteGatewayIp *string                            `json:"remote_gateway_ip,omitempty"`
+	RemoteTunnelIp  *string                            `json:"remote_tunnel_ip,omitempty"`
+	Status          *string                            `json:"status,omitempty"`
+	Zone            *transitgatewayapisv1.ZoneIdentity `json:"zone,omitempty"`
+	CreatedAt       *string                            `json:"created_at,omitempty"`
+	UpdatedAt       *string                            `json:"updated_at,omitempty"`
+}
+
+type tgRedundantGreTunnelCollection struct {
+	Tunnels []tgRedundantGreTunnel `json:"tunnels"`
+}
+
+func expandTransitGatewayTunnel(tunnelMap map[string]interface{}) tgRedundantGreTunnel {
+	tunnel := tgRedundantGreTunnel{
+		Name:            core.StringPtr(tunnelMap[tgName].(string)),
+		LocalGatewayIp:  core.StringPtr(tunnelMap[tgLocalGatewayIp].(string)),
+		LocalTunnelIp:   core.StringPtr(tunnelMap[tgLocalTunnelIp].(string)),
+		RemoteGatewayIp: core.StringPtr(tunnelMap[tgRemoteGatewayIp].(string)),
+		RemoteTunnelIp:  core.StringPtr(tunnelMap[tgRemoteTunnelIp].(string)),
+		Zone:            &transitgatewayapisv1.ZoneIdentity{Name: core.StringPtr(tunnelMap[tgZone].(string))},
+	}
+	if remoteBgpAsn := tunnelMap[tgRemoteBgpAsn].(int); remoteBgpAsn != 0 {
+		tunnel.RemoteBgpAsn = core.Int64Ptr(int64(remoteBgpAsn))
+	}
+	return tunnel
+}
+
+func expandTransitGatewayTunnels(tunnelList []interface{}) []tgRedundantGreTunnel {
+	tunnels := make([]tgRedundantGreTunnel, 0, len(tunnelList))
+	for _, tunnelItem := range tunnelList {
+		tunnels = append(tunnels, expandTransitGatewayTunnel(tunnelItem.(map[string]interface{})))
+	}
+	return tunnels
+}
+
+// flattenTransitGatewayTunnels keeps the tunnels in the order of the current
+// state, matched by name and otherwise by tunnel ID. Tunnels that are not in
+// the state yet are appended in API order.
+func flattenTransitGatewayTunnels(current []interface{}, tunnels []tgRedundantGreTunnel) []map[string]interface{} {
+	remaining := make(map[string]bool, len(tunnels))
+	for _, tunnel := range tunnels {
+		remaining[*tunnel.ID] = true
+	}
+	find := func(key, value string) *tgRedundantGreTunnel {
+		for i, tunnel := range tunnels {
+			if !remaining[*tunnel.ID] {
+				continue
+			}
+			if (key == tgName && core.StringNilMapper(tunnel.Name) == value) || (key == tgTunnelId && *tunnel.ID == value) {
+				return &tunnels[i]
+			}
+		}
+		return nil
+	}
+
+	ordered := make([]tgRedundantGreTunnel, 0, len(tunnels))
+	for _, item := range current {
+		if item == nil {
+			continue
+		}
+		itemMap := item.(map[string]interface{})
+		tunnel := find(tgName, itemMap[tgName].(string))
+		if tunnel == nil {
+			tunnel = find(tgTunnelId, itemMap[tgTunnelId].(string))
+		}
+		if tunnel != nil {
+			ordered = append(ordered, *tunnel)
+			remaining[*tunnel.ID] = false
+		}
+	}
+	for _, tunnel := range tunnels {
+		if remaining[*tunnel.ID] {
+			ordered = append(ordered, tunnel)
+		}
+	}
+
+	tunnelList := make([]map[string]interface{}, 0, len(ordered))
+	for _, tunnel := range ordered {
+		tunnelMap := map[string]interface{}{
+			tgTunnelId:        *tunnel.ID,
+			tgName:            core.StringNilMapper(tunnel.Name),
+			tgLocalGatewayIp:  core.StringNilMapper(tunnel.LocalGatewayIp),
+			tgLocalTunnelIp:   core.StringNilMapper(tunnel.LocalTunnelIp),
+			tgRemoteGatewayIp: core.StringNilMapper(tunnel.RemoteGatewayIp),
+			tgRemoteTunnelIp:  core.StringNilMapper(tunnel.RemoteTunnelIp),
+			tgStatus:          core.StringNilMapper(tunnel.Status),
+			tgCreatedAt:       core.StringNilMapper(tunnel.CreatedAt),
+			tgUpdatedAt:       core.StringNilMapper(tunnel.UpdatedAt),
+		}
+		if tunnel.Zone != nil {
+			tunnelMap[tgZone] = core.StringNilMapper(tunnel.Zone.Name)
+		}
+		if tunnel.LocalBgpAsn != nil {
+			tunnelMap[tgLocalBgpAsn] = int(*tunnel.LocalBgpAsn)
+		}
+		if tunnel.RemoteBgpAsn != nil {
+			tunnelMap[tgRemoteBgpAsn] = int(*tunnel.RemoteBgpAsn)
+		}
+		if tunnel.Mtu != nil {
+			tunnelMap[tgMtu] = int(*tunnel.Mtu)
+		}
+		tunnelList = append(tunnelList, tunnelMap)
+	}
+	return tunnelList
+}
+
+// updateTransitGatewayTunnels applies the tunnel changes computed by
+// DiffTransitGatewayTunnels, tunnels that did not change are left alone.
+func updateTransitGatewayTunnels(d *schema.ResourceData, client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId string) error {
+	o, n := d.GetChange(tgTunnels)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	toDelete, toCreate, toRename := DiffTransitGatewayTunnels(o.([]interface{}), n.([]interface{}))
+
+	// Tunnels are removed first, a replaced tunnel usually keeps its addresses.
+	for _, tunnelId := range toDelete {
+		response, err := transitGatewayTunnelRequest(client, core.DELETE, gatewayId, connectionId, tunnelId, nil, nil)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection (%s) tunnel (%s): %s\n%s", connectionId, tunnelId, err, response)
+		}
+		_, err = isWaitForTransitGatewayTunnelDeleted(client, gatewayId, connectionId, tunnelId, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	for _, tunnel := range toCreate {
+		created := &tgRedundantGreTunnel{}
+		response, err := transitGatewayTunnelRequest(client, core.POST, gatewayId, connectionId, "", tunnel, created)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating Transit Gateway Connection (%s) tunnel (%s): %s\n%s", connectionId, *tunnel.Name, err, response)
+		}
+		_, err = isWaitForTransitGatewayTunnelAvailable(client, gatewayId, connectionId, *created.ID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	for tunnelId, name := range toRename {
+		patch := map[string]interface{}{
+			tgName: name,
+		}
+		response, err := transitGatewayTunnelRequest(client, core.PATCH, gatewayId, connectionId, tunnelId, patch, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating Transit Gateway Connection (%s) tunnel (%s): %s\n%s", connectionId, tunnelId, err, response)
+		}
+	}
+	return nil
+}
+
+// DiffTransitGatewayTunnels matches the new tunnels to the old ones by name,
+// and a renamed tunnel to an old tunnel with the same settings. Matched
+// tunnels whose settings changed are replaced, matched tunnels with a new name
+// are renamed, unmatched old tunnels are deleted and unmatched new tunnels are
+// created. The position of a tunnel in the list does not matter.
+func DiffTransitGatewayTunnels(oldTunnels, newTunnels []interface{}) ([]string, []tgRedundantGreTunnel, map[string]string) {
+	toDelete := []string{}
+	toCreate := []tgRedundantGreTunnel{}
+	toRename := map[string]string{}
+
+	unmatched := make([]map[string]interface{}, 0, len(oldTunnels))
+	for _, item := range oldTunnels {
+		if item != nil {
+			unmatched = append(unmatched, item.(map[string]interface{}))
+		}
+	}
+	take := func(match func(map[string]interface{}) bool) map[string]interface{} {
+		for i, oldTunnel := range unmatched {
+			if match(oldTunnel) {
+				unmatched = append(unmatched[:i], unmatched[i+1:]...)
+				return oldTunnel
+			}
+		}
+		return nil
+	}
+
+	pairs := make([][2]map[string]interface{}, 0, len(newTunnels))
+	renamed := []map[string]interface{}{}
+	for _, item := range newTunnels {
+		newTunnel := item.(map[string]interface{})
+		if oldTunnel := take(func(t map[string]interface{}) bool { return t[tgName] == newTunnel[tgName] }); oldTunnel != nil {
+			pairs = append(pairs, [2]map[string]interface{}{oldTunnel, newTunnel})
+			continue
+		}
+		renamed = append(renamed, newTunnel)
+	}
+	for _, newTunnel := range renamed {
+		oldTunnel := take(func(t map[string]interface{}) bool { return !isTransitGatewayTunnelReplaced(t, newTunnel) })
+		if oldTunnel == nil {
+			toCreate = append(toCreate, expandTransitGatewayTunnel(newTunnel))
+			continue
+		}
+		pairs = append(pairs, [2]map[string]interface{}{oldTunnel, newTunnel})
+	}
+
+	for _, pair := range pairs {
+		oldTunnel, newTunnel := pair[0], pair[1]
+		tunnelId := oldTunnel[tgTunnelId].(string)
+		if isTransitGatewayTunnelReplaced(oldTunnel, newTunnel) {
+			toDelete = append(toDelete, tunnelId)
+			toCreate = append(toCreate, expandTransitGatewayTunnel(newTunnel))
+		} else if oldTunnel[tgName] != newTunnel[tgName] {
+			toRename[tunnelId] = newTunnel[tgName].(string)
+		}
+	}
+	for _, oldTunnel := range unmatched {
+		toDelete = append(toDelete, oldTunnel[tgTunnelId].(string))
+	}
+	return toDelete, toCreate, toRename
+}
+
+// isTransitGatewayTunnelReplaced reports whether a tunnel changed in a way
+// that the API cannot update. Only the name of a tunnel can be updated.
+func isTransitGatewayTunnelReplaced(oldTunnel, newTunnel map[string]interface{}) bool {
+	for _, key := range []string{tgLocalGatewayIp, tgLocalTunnelIp, tgRemoteGatewayIp, tgRemoteTunnelIp, tgZone} {
+		if oldTunnel[key] != newTunnel[key] {
+			return true
+		}
+	}
+	remoteBgpAsn := newTunnel[tgRemoteBgpAsn].(int)
+	return remoteBgpAsn != 0 && remoteBgpAsn != oldTunnel[tgRemoteBgpAsn].(int)
+}
+
+func isWaitForTransitGatewayTunnelAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId, tunnelId string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for transit gateway connection (%s) tunnel (%s) to be available.", connectionId, tunnelId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isTransitGatewayConnectionPending},
+		Target:     []string{isTransitGatewayConnectionAttached, ""},
+		Refresh:    isTransitGatewayTunnelRefreshFunc(client, gatewayId, connectionId, tunnelId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isWaitForTransitGatewayTunnelDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId, tunnelId string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for transit gateway connection (%s) tunnel (%s) to be deleted.", connectionId, tunnelId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isTransitGatewayConnectionDeleting, isTransitGatewayConnectionDetaching, isTransitGatewayConnectionPending, isTransitGatewayConnectionAttached},
+		Target:     []string{isTransitGatewayTunnelDeleted, isTransitGatewayConnectionDeleted},
+		Refresh:    isTransitGatewayTunnelRefreshFunc(client, gatewayId, connectionId, tunnelId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isTransitGatewayTunnelRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId, tunnelId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		tunnel := &tgRedundantGreTunnel{}
+		response, err := transitGatewayTunnelRequest(client, core.GET, gatewayId, connectionId, tunnelId, nil, tunnel)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return tunnel, isTransitGatewayTunnelDeleted, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s) tunnel (%s): %s\n%s", connectionId, tunnelId, err, response)
+		}
+		if tunnel.Status == nil {
+			return tunnel, isTransitGatewayConnectionPending, nil
+		}
+		if *tunnel.Status == "failed" {
+			return tunnel, isTransitGatewayConnectionAttached, nil
+		}
+		return tunnel, *tunnel.Status, nil
+	}
+}
+
+// createTransitGatewayRedundantGreConnection creates a connection with the
+// given options and tunnels.
+func createTransitGatewayRedundantGreConnection(client *transitgatewayapisv1.TransitGatewayApisV1, options *transitgatewayapisv1.CreateTransitGatewayConnectionOptions, tunnels []tgRedundantGreTunnel) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+	body := map[string]interface{}{
+		tgNetworkType: options.NetworkType,
+		tgTunnels:     tunnels,
+	}
+	if options.Name != nil {
+		body[tgName] = options.Name
+	}
+	if options.BaseNetworkType != nil {
+		body[tgBaseNetworkType] = options.BaseNetworkType
+	}
+	if options.NetworkID != nil {
+		body[tgNetworkId] = options.NetworkID
+	}
+	if options.NetworkAccountID != nil {
+		body[tgNetworkAccountID] = options.NetworkAccountID
+	}
+
+	connection := &transitgatewayapisv1.TransitGatewayConnectionCust{}
+	pathParamsMap := map[string]string{
+		"transit_gateway_id": *options.TransitGatewayID,
+	}
+	response, err := transitGatewayRequest(client, core.POST, `/transit_gateways/{transit_gateway_id}/connections`, pathParamsMap, body, connection)
+	return connection, response, err
+}
+
+// transitGatewayTunnelRequest calls the tunnels API of a redundant GRE
+// connection. The tunnel ID is empty for the collection.
+func transitGatewayTunnelRequest(client *transitgatewayapisv1.TransitGatewayApisV1, method, gatewayId, connectionId, tunnelId string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	path := `/transit_gateways/{transit_gateway_id}/connections/{id}/tunnels`
+	pathParamsMap := map[string]string{
+		"transit_gateway_id": gatewayId,
+		"id":                 connectionId,
+	}
+	if tunnelId != "" {
+		path += `/{gre_tunnel_id}`
+		pathParamsMap["gre_tunnel_id"] = tunnelId
+	}
+	return transitGatewayRequest(client, method, path, pathParamsMap, body, result)
+}
+
+func transitGatewayRequest(client *transitgatewayapisv1.TransitGatewayApisV1, method, path string, pathParamsMap map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", fmt.Sprint(*client.Version))
+
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
@@ -11,11 +11,13 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/transitgateway"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
@@ -89,6 +91,91 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 	)
 }
 
+func TestAccIBMTransitGatewayConnection_redundantGre(t *testing.T) {
+	var tgConnection string
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	classicConnName := fmt.Sprintf("tg-connection-name-%d", acctest.RandIntRange(10, 100))
+	rgreConnName := fmt.Sprintf("tg-rgre-connection-name-%d", acctest.RandIntRange(10, 100))
+	tunnelName := fmt.Sprintf("tg-tunnel-name-%d", acctest.RandIntRange(10, 100))
+	updatedTunnelName := fmt.Sprintf("tg-tunnel-name-%d", acctest.RandIntRange(100, 200))
+	secondTunnel := `
+	tunnels {
+		name = "tg-second-tunnel"
+		local_gateway_ip = "192.168.102.1"
+		local_tunnel_ip = "192.168.103.1"
+		remote_gateway_ip = "10.242.63.13"
+		remote_tunnel_ip = "192.168.103.2"
+		zone = "us-south-2"
+	}`
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayRedundantGreConnectionConfig(gatewayName, classicConnName, rgreConnName, tunnelName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_ibm_tg_rgre_connection", tgConnection),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_rgre_connection", "tunnels.#", "1"),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_rgre_connection", "tunnels.0.name", tunnelName),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_rgre_connection", "tunnels.0.tunnel_id"),
+				),
+			},
+			// rename the tunnel in place and add a tunnel in a second zone
+			{
+				Config: testAccCheckIBMTransitGatewayRedundantGreConnectionConfig(gatewayName, classicConnName, rgreConnName, updatedTunnelName, secondTunnel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_ibm_tg_rgre_connection", tgConnection),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_rgre_connection", "tunnels.#", "2"),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_rgre_connection", "tunnels.0.name", updatedTunnelName),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_rgre_connection", "tunnels.1.zone", "us-south-2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_tg_connection.test_ibm_tg_rgre_connection",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"updated_at", "tunnels.0.updated_at", "tunnels.1.updated_at"},
+			},
+		},
+	},
+	)
+}
+
+func testAccCheckIBMTransitGatewayRedundantGreConnectionConfig(gatewayName, classicConnName, rgreConnName, tunnelName, extraTunnels string) string {
+	return fmt.Sprintf(`
+resource "ibm_tg_gateway" "test_tg_gateway"{
+	name="%s"
+	location="us-south"
+	global=true
+}
+
+resource "ibm_tg_connection" "test_ibm_tg_classic_connection"{
+	gateway = "${ibm_tg_gateway.test_tg_gateway.id}"
+	network_type = "classic"
+	name = "%s"
+}
+
+resource "ibm_tg_connection" "test_ibm_tg_rgre_connection"{
+	depends_on = [ibm_tg_connection.test_ibm_tg_classic_connection]
+	gateway = "${ibm_tg_gateway.test_tg_gateway.id}"
+	network_type = "redundant_gre"
+	name = "%s"
+	base_network_type = "classic"
+	tunnels {
+		name = "%s"
+		local_gateway_ip = "192.168.100.1"
+		local_tunnel_ip = "192.168.101.1"
+		remote_gateway_ip = "10.242.63.12"
+		remote_tunnel_ip = "192.168.101.2"
+		zone = "us-south-1"
+	}
+	%s
+}
+	  `, gatewayName, classicConnName, rgreConnName, tunnelName, extraTunnels)
+}
+
 func testAccCheckIBMTransitGatewayCrossAccConnectionConfig(vcName, gatewayName, vpcName string) string {
 	return fmt.Sprintf(`	
 	resource "ibm_is_vpc" "test_tg_vpc" {
@@ -334,3 +421,53 @@ func TestAccIBMTransitGatewayConnectionImport(t *testing.T) {
 		},
 	})
 }
+
+func TestDiffTransitGatewayTunnels(t *testing.T) {
+	tunnel := func(id, name, localTunnelIp string) map[string]interface{} {
+		return map[string]interface{}{
+			"tunnel_id":         id,
+			"name":              name,
+			"local_gateway_ip":  "192.168.100.20",
+			"local_tunnel_ip":   localTunnelIp,
+			"remote_gateway_ip": "10.242.63.12",
+			"remote_tunnel_ip":  "192.168.101.2",
+			"zone":              "us-south-1",
+			"remote_bgp_asn":    65010,
+		}
+	}
+	a := tunnel("id-a", "tunnel-a", "192.168.101.1")
+	b := tunnel("id-b", "tunnel-b", "192.168.102.1")
+	c := tunnel("id-c", "tunnel-c", "192.168.103.1")
+
+	testcases := []struct {
+		oldTunnels []interface{}
+		newTunnels []interface{}
+		toDelete   []string
+		toCreate   []string
+		toRename   map[string]string
+	}{
+		// reordered
+		{[]interface{}{a, b, c}, []interface{}{c, a, b}, []string{}, []string{}, map[string]string{}},
+		// first tunnel removed
+		{[]interface{}{a, b, c}, []interface{}{b, c}, []string{"id-a"}, []string{}, map[string]string{}},
+		// tunnel inserted in the middle
+		{[]interface{}{a, c}, []interface{}{a, b, c}, []string{}, []string{"tunnel-b"}, map[string]string{}},
+		// tunnel renamed
+		{[]interface{}{a, b}, []interface{}{a, tunnel("", "tunnel-b2", "192.168.102.1")}, []string{}, []string{}, map[string]string{"id-b": "tunnel-b2"}},
+		// tunnel settings changed
+		{[]interface{}{a, b}, []interface{}{tunnel("", "tunnel-a", "192.168.104.1"), b}, []string{"id-a"}, []string{"tunnel-a"}, map[string]string{}},
+		// tunnel renamed and changed
+		{[]interface{}{a, b}, []interface{}{tunnel("", "tunnel-d", "192.168.104.1"), b}, []string{"id-a"}, []string{"tunnel-d"}, map[string]string{}},
+	}
+
+	for _, c := range testcases {
+		toDelete, toCreate, toRename := transitgateway.DiffTransitGatewayTunnels(c.oldTunnels, c.newTunnels)
+		created := []string{}
+		for _, tunnel := range toCreate {
+			created = append(created, *tunnel.Name)
+		}
+		assert.DeepEqual(t, c.toDelete, toDelete)
+		assert.DeepEqual(t, c.toCreate, created)
+		assert.DeepEqual(t, c.toRename, toRename)
+	}
+}